@@ -0,0 +1,199 @@
+// Command uniseg shows how text is split into grapheme clusters by the uniseg
+// package. The text is taken from the command line arguments (separated by
+// spaces) or, if there are none, from standard input.
+//
+// By default, each grapheme cluster is printed on its own line, with its byte
+// offsets, its code points, their grapheme cluster break properties, and the
+// cluster's monospace width:
+//
+//   $ uniseg $'e\u0301!'
+//   OFFSETS  CLUSTER  CODE POINTS    PROPERTIES    WIDTH
+//   0-3      "é"      U+0065 U+0301  Other Extend  1
+//   3-4      "!"      U+0021         Other         1
+//
+// Bytes which are not valid UTF-8 are shown in hexadecimal (e.g. "0xFF") with
+// the property "Invalid".
+//
+// With the -test flag, each line of the text is printed in the notation of the
+// Unicode test file GraphemeBreakTest.txt, with "÷" marking a boundary and "×"
+// marking a position without a boundary, followed by a comment with the rule
+// applied at each position (see uniseg.TraceGraphemes()):
+//
+//   $ uniseg -test $'e\u0301!'
+//   ÷ 0065 × 0301 ÷ 0021 ÷	#  ÷ [0.2] 0065 × [9.0] 0301 ÷ [999.0] 0021 ÷ [0.3]
+//
+// These lines can be added to a copy of GraphemeBreakTest.txt to generate test
+// cases with gen_breaktest.go. Lines are separated by "\n" and a trailing "\r"
+// is removed. With the additional -whole flag, the entire text, including any
+// line terminators, is printed as a single test case instead. The -test flag
+// rejects text which is not valid UTF-8 as it cannot be written in this
+// notation.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "uniseg:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command with the given arguments (without the program
+// name), reading from "stdin" if there are no text arguments and writing to
+// "stdout".
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("uniseg", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	test := flags.Bool("test", false, "print each line in the notation of GraphemeBreakTest.txt")
+	whole := flags.Bool("whole", false, "with -test, print the entire text, including line terminators, as one test case")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: uniseg [-test [-whole]] [text ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// Get the text.
+	var text string
+	if flags.NArg() > 0 {
+		text = strings.Join(flags.Args(), " ")
+	} else {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		text = string(b)
+	}
+
+	if *test {
+		return printTest(stdout, text, *whole)
+	}
+	return printClusters(stdout, text)
+}
+
+// printClusters writes a table of the grapheme clusters of the given text to
+// the writer.
+func printClusters(w io.Writer, text string) error {
+	rows := [][]string{{"OFFSETS", "CLUSTER", "CODE POINTS", "PROPERTIES", "WIDTH"}}
+	var (
+		cluster string
+		offset  int
+		state   uniseg.GraphemeState
+	)
+	for rest := text; len(rest) > 0; {
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		var codePoints, properties []string
+		for index := 0; index < len(cluster); {
+			r, length := utf8.DecodeRuneInString(cluster[index:])
+			if r == utf8.RuneError && length == 1 {
+				codePoints = append(codePoints, fmt.Sprintf("0x%02X", cluster[index]))
+				properties = append(properties, "Invalid")
+			} else {
+				codePoints = append(codePoints, fmt.Sprintf("U+%04X", r))
+				properties = append(properties, uniseg.GraphemeProperty(r).String())
+			}
+			index += length
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d-%d", offset, offset+len(cluster)),
+			fmt.Sprintf("%q", cluster),
+			strings.Join(codePoints, " "),
+			strings.Join(properties, " "),
+			fmt.Sprint(uniseg.StringWidth(cluster)),
+		})
+		offset += len(cluster)
+	}
+	return printTable(w, rows)
+}
+
+// printTable writes the given rows to the writer, aligning the columns by
+// their monospace width.
+func printTable(w io.Writer, rows [][]string) error {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for column, cell := range row {
+			if width := uniseg.StringWidth(cell); width > widths[column] {
+				widths[column] = width
+			}
+		}
+	}
+	for _, row := range rows {
+		var line strings.Builder
+		for column, cell := range row {
+			line.WriteString(cell)
+			if column < len(row)-1 {
+				line.WriteString(strings.Repeat(" ", widths[column]-uniseg.StringWidth(cell)+2))
+			}
+		}
+		if _, err := fmt.Fprintln(w, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printTest writes each line of the given text (without line terminators) to
+// the writer in the notation of GraphemeBreakTest.txt. Empty lines are skipped.
+// If "whole" is true, the entire text is written as one line instead. An error
+// is returned if the text is not valid UTF-8.
+func printTest(w io.Writer, text string, whole bool) error {
+	if offset := invalidOffset(text); offset >= 0 {
+		return fmt.Errorf("invalid UTF-8 at byte offset %d", offset)
+	}
+	lines := []string{text}
+	if !whole {
+		lines = strings.Split(text, "\n")
+	}
+	for _, line := range lines {
+		if !whole {
+			line = strings.TrimSuffix(line, "\r")
+		}
+		if line == "" {
+			continue
+		}
+		var (
+			cluster string
+			state   uniseg.GraphemeState
+			b       strings.Builder
+		)
+		b.WriteString("÷")
+		for rest := line; len(rest) > 0; {
+			cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+			separator := " "
+			for _, r := range cluster {
+				fmt.Fprintf(&b, "%s%04X", separator, r)
+				separator = " × "
+			}
+			b.WriteString(" ÷")
+		}
+		if _, err := fmt.Fprintf(w, "%s\t#  %s\n", b.String(), uniseg.TraceGraphemes(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invalidOffset returns the byte offset of the first invalid UTF-8 sequence in
+// the given text or -1 if the text is valid UTF-8.
+func invalidOffset(text string) int {
+	for index, r := range text {
+		if r == utf8.RuneError {
+			if _, length := utf8.DecodeRuneInString(text[index:]); length == 1 {
+				return index
+			}
+		}
+	}
+	return -1
+}
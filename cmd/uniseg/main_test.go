@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// Test the output of the command.
+func TestRun(t *testing.T) {
+	for index, testCase := range []struct {
+		args     []string
+		stdin    string
+		expected string
+		err      string
+	}{
+		{
+			args: []string{"e\u0301!"},
+			expected: "OFFSETS  CLUSTER  CODE POINTS    PROPERTIES    WIDTH\n" +
+				"0-3      \"e\u0301\"      U+0065 U+0301  Other Extend  1\n" +
+				"3-4      \"!\"      U+0021         Other         1\n",
+		},
+		{
+			args: []string{"a", "b"},
+			expected: `OFFSETS  CLUSTER  CODE POINTS  PROPERTIES  WIDTH
+0-1      "a"      U+0061       Other       1
+1-2      " "      U+0020       Other       1
+2-3      "b"      U+0062       Other       1
+`,
+		},
+		{
+			stdin: "\U0001f1e9\U0001f1ea\r\n",
+			expected: `OFFSETS  CLUSTER  CODE POINTS      PROPERTIES                             WIDTH
+0-8      "🇩🇪"     U+1F1E9 U+1F1EA  Regional_Indicator Regional_Indicator  2
+8-10     "\r\n"   U+000D U+000A    CR LF                                  0
+`,
+		},
+		{
+			stdin: "a\xffb",
+			expected: `OFFSETS  CLUSTER  CODE POINTS  PROPERTIES  WIDTH
+0-1      "a"      U+0061       Other       1
+1-2      "\xff"   0xFF         Invalid     1
+2-3      "b"      U+0062       Other       1
+`,
+		},
+		{
+			args:     []string{"-test", "e\u0301!"},
+			expected: "÷ 0065 × 0301 ÷ 0021 ÷\t#  ÷ [0.2] 0065 × [9.0] 0301 ÷ [999.0] 0021 ÷ [0.3]\n",
+		},
+		{
+			args:  []string{"-test"},
+			stdin: "x\u200d\n\n\U0001f1e9\U0001f1ea\U0001f1e9\r\n",
+			expected: "÷ 0078 × 200D ÷\t#  ÷ [0.2] 0078 × [9.0] 200D ÷ [0.3]\n" +
+				"÷ 1F1E9 × 1F1EA ÷ 1F1E9 ÷\t#  ÷ [0.2] 1F1E9 × [12.0] 1F1EA ÷ [999.0] 1F1E9 ÷ [0.3]\n",
+		},
+		{
+			args:     []string{"-test", "-whole"},
+			stdin:    "a\r\n\r",
+			expected: "÷ 0061 ÷ 000D × 000A ÷ 000D ÷\t#  ÷ [0.2] 0061 ÷ [5.0] 000D × [3.0] 000A ÷ [4.0] 000D ÷ [0.3]\n",
+		},
+		{
+			args:  []string{"-test"},
+			stdin: "ab\n\xe0\x80",
+			err:   "invalid UTF-8 at byte offset 3",
+		},
+	} {
+		var stdout bytes.Buffer
+		err := run(testCase.args, strings.NewReader(testCase.stdin), &stdout)
+		if testCase.err != "" {
+			if err == nil || err.Error() != testCase.err {
+				t.Errorf("Test case %d failed: Expected error %q, got %v", index, testCase.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test case %d failed: %v", index, err)
+			continue
+		}
+		if stdout.String() != testCase.expected {
+			t.Errorf("Test case %d failed: Expected\n%s\ngot\n%s", index, testCase.expected, stdout.String())
+		}
+	}
+}
@@ -1,8 +1,47 @@
 /*
 Package uniseg implements Unicode Text Segmentation according to Unicode
-Standard Annex #29 (http://unicode.org/reports/tr29/).
+Standard Annex #29 (http://unicode.org/reports/tr29/) and Unicode Line Breaking
+according to Unicode Standard Annex #14 (http://unicode.org/reports/tr14/).
 
-At this point, only the determination of grapheme cluster boundaries is
-implemented.
+At this point, the determination of grapheme cluster boundaries, word
+boundaries, sentence boundaries, and line break opportunities is implemented.
+In addition, the monospace display width of strings and grapheme clusters can
+be determined with StringWidth() and Graphemes.Width().
+
+Invalid UTF-8
+
+Bytes which are not part of a valid UTF-8 encoded code point are treated like
+control characters by the grapheme cluster functions, i.e. each such byte is a
+grapheme cluster of its own and never combines with neighboring code points.
+All strings and byte slices returned by this package are taken from the
+original input so invalid bytes are preserved. Only functions which return
+runes, e.g. Graphemes.Runes(), substitute utf8.RuneError (U+FFFD) for them. Use
+Graphemes.Invalid() to find the positions of invalid bytes.
+
+Unicode Versions
+
+By default, this package uses the character properties of Unicode 15.0.0. To
+match the segmentation of platforms which still use an older version of the
+Unicode Standard, tables for the following versions can be selected with build
+tags:
+
+   unicode14: Unicode 14.0.0
+   unicode15: Unicode 15.0.0 (currently also the default)
+
+The tag of the default version has no effect yet. It keeps selecting that
+version once a newer one becomes the default.
+
+For example:
+
+   go build -tags unicode14
+
+The UnicodeVersion constant holds the version which was compiled into the
+package.
+
+The grapheme cluster parser also implements rule GB9c of Unicode 15.1.0, which
+keeps Indic conjuncts (e.g. Devanagari consonant + virama + consonant) in one
+cluster. It is based on the Indic_Conjunct_Break property which only tables
+generated from Unicode 15.1.0 or later contain (see the -incb flag of
+gen_properties.go). With the tables listed above, the rule has no effect.
 */
 package uniseg
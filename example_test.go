@@ -1,7 +1,9 @@
 package uniseg_test
 
 import (
+	"bufio"
 	"fmt"
+	"strings"
 
 	"github.com/rivo/uniseg"
 )
@@ -14,8 +16,249 @@ func ExampleGraphemes() {
 	// Output: [1f44d 1f3fc] [21]
 }
 
+func ExampleGraphemes_Init() {
+	var gr uniseg.Graphemes
+	for _, message := range []string{"Käse", "🇩🇪🏳️‍🌈"} {
+		gr.Init(message)
+		var n int
+		for gr.Next() {
+			n++
+		}
+		fmt.Println(n)
+	}
+	// Output: 4
+	// 2
+}
+
 func ExampleGraphemeClusterCount() {
 	n := uniseg.GraphemeClusterCount("🇩🇪🏳️‍🌈")
 	fmt.Println(n)
 	// Output: 2
 }
+
+func ExampleFirstGraphemeCluster() {
+	b := []byte("🇩🇪🏳️‍🌈!")
+	var (
+		c     []byte
+		state uniseg.GraphemeState
+	)
+	for len(b) > 0 {
+		c, b, _, state = uniseg.FirstGraphemeCluster(b, state)
+		fmt.Println(string(c))
+	}
+	// Output: 🇩🇪
+	// 🏳️‍🌈
+	// !
+}
+
+func ExampleFirstGraphemeClusterInString() {
+	str := "Käse"
+	var (
+		c     string
+		state uniseg.GraphemeState
+	)
+	for len(str) > 0 {
+		c, str, _, state = uniseg.FirstGraphemeClusterInString(str, state)
+		fmt.Println(c)
+	}
+	// Output: K
+	// ä
+	// s
+	// e
+}
+
+func ExampleWords() {
+	w := uniseg.NewWords("Can't stop at 3.14!")
+	for w.Next() {
+		fmt.Printf("%q ", w.Str())
+	}
+	// Output: "Can't" " " "stop" " " "at" " " "3.14" "!"
+}
+
+func ExampleFirstWordInString() {
+	str := "Hello, world!"
+	var (
+		w     string
+		state uniseg.WordState
+	)
+	for len(str) > 0 {
+		w, str, state = uniseg.FirstWordInString(str, state)
+		fmt.Printf("(%s)", w)
+	}
+	// Output: (Hello)(,)( )(world)(!)
+}
+
+func ExampleSentences() {
+	s := uniseg.NewSentences("Mr. Smith arrived. Did he? Yes!")
+	for s.Next() {
+		fmt.Printf("%q ", s.Str())
+	}
+	// Output: "Mr. " "Smith arrived. " "Did he? " "Yes!"
+}
+
+func ExampleFirstSentenceInString() {
+	str := "This is sentence 1.0. And this is sentence two."
+	var (
+		s     string
+		state uniseg.SentenceState
+	)
+	for len(str) > 0 {
+		s, str, state = uniseg.FirstSentenceInString(str, state)
+		fmt.Printf("(%s)\n", s)
+	}
+	// Output: (This is sentence 1.0. )
+	// (And this is sentence two.)
+}
+
+func ExampleFirstLineSegmentInString() {
+	str := "First line.\nSecond line."
+	var (
+		segment   string
+		mustBreak bool
+		state     uniseg.LineState
+	)
+	for len(str) > 0 {
+		segment, str, mustBreak, state = uniseg.FirstLineSegmentInString(str, state)
+		fmt.Printf("(%s)", segment)
+		if mustBreak {
+			fmt.Print("!")
+		}
+	}
+	// Output: (First )(line.
+	// )!(Second )(line.)!
+}
+
+func ExampleStringWidth() {
+	fmt.Println(uniseg.StringWidth("Hello, 世界! 🇩🇪"))
+	// Output: 15
+}
+
+func ExampleGraphemes_Width() {
+	g := uniseg.NewGraphemes("a世🏳️‍🌈")
+	for g.Next() {
+		fmt.Printf("%s:%d ", g.Str(), g.Width())
+	}
+	// Output: a:1 世:2 🏳️‍🌈:2
+}
+
+func ExampleScanGraphemes() {
+	scanner := bufio.NewScanner(strings.NewReader("Käse 🏳️‍🌈"))
+	scanner.Split(uniseg.ScanGraphemes)
+	for scanner.Scan() {
+		fmt.Printf("(%s)", scanner.Text())
+	}
+	// Output: (K)(ä)(s)(e)( )(🏳️‍🌈)
+}
+
+func ExampleLastGraphemeClusterInString() {
+	str := "Käse🇩🇪"
+	var c string
+	for len(str) > 0 {
+		c, str = uniseg.LastGraphemeClusterInString(str)
+		fmt.Println(c)
+	}
+	// Output: 🇩🇪
+	// e
+	// s
+	// ä
+	// K
+}
+
+func ExampleGraphemes_Prev() {
+	gr := uniseg.NewGraphemes("👍🏼!?")
+	for gr.Next() {
+	}
+	for gr.Prev() {
+		fmt.Printf("%x ", gr.Runes())
+	}
+	// Output: [3f] [21] [1f44d 1f3fc]
+}
+
+func ExampleGraphemes_Invalid() {
+	gr := uniseg.NewGraphemes("Käse\xff!")
+	for gr.Next() {
+		if gr.Invalid() {
+			from, to := gr.Positions()
+			fmt.Printf("Invalid UTF-8 at %d-%d: %q\n", from, to, gr.Str())
+		}
+	}
+	// Output: Invalid UTF-8 at 5-6: "\xff"
+}
+
+func ExampleIsGraphemeBoundary() {
+	str := "🇩🇪🇫🇷"
+	for offset := 0; offset <= len(str); offset += 4 {
+		fmt.Print(uniseg.IsGraphemeBoundary(str, offset), " ")
+	}
+	// Output: true false true false true
+}
+
+func ExampleGraphemes_Seek() {
+	gr := uniseg.NewGraphemes("Käse🇩🇪!")
+	gr.Seek(10) // The second regional indicator of the flag.
+	fmt.Println(gr.Str())
+	gr.Next()
+	fmt.Println(gr.Str())
+	// Output: 🇩🇪
+	// !
+}
+
+func ExampleSnapGraphemeBoundaries() {
+	str := "Cafe\u0301 au lait"
+	start, end := uniseg.SnapGraphemeBoundaries(str, 0, 4, true) // "Cafe" without the accent.
+	fmt.Printf("%+q\n", str[start:end])
+	// Output: "Cafe\u0301"
+}
+
+func ExampleTruncate() {
+	fmt.Println(uniseg.Truncate("Hello, 世界!", 10, "…"))
+	fmt.Println(uniseg.TruncateMiddle("/usr/local/share/doc", 12, "…"))
+	fmt.Println(uniseg.TruncateStart("👍🏼👍🏼👍🏼", 5, "…"))
+	// Output: Hello, 世…
+	// /usr/l…e/doc
+	// …👍🏼👍🏼
+}
+
+func ExampleWrap() {
+	for _, line := range uniseg.Wrap("The quick brown fox jumps over the lazy dog.", 10) {
+		fmt.Printf("|%-10s|\n", line)
+	}
+	// Output: |The quick |
+	// |brown fox |
+	// |jumps over|
+	// |the lazy  |
+	// |dog.      |
+}
+
+func ExampleWrappedLineCount() {
+	fmt.Println(uniseg.WrappedLineCount("世界世界世界世界 hello", 5))
+	// Output: 5
+}
+
+func ExampleReverse() {
+	fmt.Println(uniseg.Reverse("🇩🇪🏳️‍🌈!"))
+	// Output: !🏳️‍🌈🇩🇪
+}
+
+func ExampleSubstring() {
+	fmt.Println(uniseg.Substring("🇩🇪🏳️‍🌈👍🏼!", 1, 3))
+	// Output: 🏳️‍🌈👍🏼
+}
+
+func ExampleTraceGraphemes() {
+	fmt.Println(uniseg.TraceGraphemes("e\u0301!"))
+	// Output: ÷ [0.2] 0065 × [9.0] 0301 ÷ [999.0] 0021 ÷ [0.3]
+}
+
+func ExampleGraphemeProperty() {
+	for _, r := range "e\u0301\u200d" {
+		fmt.Println(uniseg.GraphemeProperty(r))
+	}
+	// Output: Other
+	// Extend
+	// ZWJ
+}
+
+func ExampleUnicodeVersion() {
+	fmt.Println("Using Unicode", uniseg.UnicodeVersion)
+}
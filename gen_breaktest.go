@@ -1,10 +1,31 @@
 //go:build generate
 
-// This program generates grapheme_break_test.go from the Unicode Character
-// Database auxiliary data files at https://www.unicode.org/Public/
-// Either directly via HTTP by URL or from a local copy of the file.
+// This program generates the grapheme cluster, word, sentence, and line break
+// test cases from the Unicode Character Database auxiliary data files at
+// https://www.unicode.org/Public/
+// Either directly via HTTP by URL or from a local copy of the Unicode Character
+// Database (see the -ucd and -checksums flags in gen_ucd.go). The following
+// flags are supported:
 //
-//go:generate go run gen_breaktest.go
+//   -version   The version of the Unicode Character Database, e.g. "15.0.0".
+//   -test      The name of the test file, without extension, e.g.
+//              "WordBreakTest". Defaults to "GraphemeBreakTest".
+//   -variable  The name of the slice containing the test cases. Defaults to
+//              "unicodeTestCases".
+//   -output    The name of the generated Go file.
+//   -build     A build constraint for the generated Go file, e.g. "unicode14".
+//              Optional, it is derived from -version by default (see
+//              ucdVersions in gen_ucd.go).
+//
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -output grapheme_break_unicode15_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -test WordBreakTest -variable wordBreakTestCases -output word_break_unicode15_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -test SentenceBreakTest -variable sentenceBreakTestCases -output sentence_break_unicode15_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -test LineBreakTest -variable lineBreakTestCases -output line_break_unicode15_test.go
+//
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -output grapheme_break_unicode14_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -test WordBreakTest -variable wordBreakTestCases -output word_break_unicode14_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -test SentenceBreakTest -variable sentenceBreakTestCases -output sentence_break_unicode14_test.go
+//go:generate go run gen_breaktest.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -test LineBreakTest -variable lineBreakTestCases -output line_break_unicode14_test.go
 
 package main
 
@@ -12,34 +33,53 @@ import (
 	"bufio"
 	"bytes"
 	"errors"
+	"flag"
 	"fmt"
 	"go/format"
-	"io"
 	"io/ioutil"
 	"log"
-	"net/http"
-	"os"
 	"strings"
 )
 
 // See https://www.unicode.org/license.html for the Unicode license agreement.
 
-// We want to test against a specific version rather than the latest, which
-// can be found at:
+// We want to test against specific versions rather than the latest, which
+// can be found at e.g.:
 // https://www.unicode.org/Public/UCD/latest/ucd/auxiliary/GraphemeBreakTest.txt
-// When/if the package is upgraded to a new version, change these to generate
-// new tests.
+// The test name and the version are inserted into these names. The file name is
+// found on the file's first line.
 const (
-	url      = `https://www.unicode.org/Public/14.0.0/ucd/auxiliary/GraphemeBreakTest.txt`
-	filename = `GraphemeBreakTest-14.0.0.txt`
+	testFilePattern = `auxiliary/%s.txt`
+	filenamePattern = `%s-%s.txt`
 )
 
 func main() {
-	log.SetPrefix("gen_breaktest: ")
+	version := flag.String("version", "", "the version of the Unicode Character Database")
+	test := flag.String("test", "GraphemeBreakTest", "the name of the test file, without extension")
+	variable := flag.String("variable", "unicodeTestCases", "the name of the generated slice")
+	output := flag.String("output", "", "the name of the generated Go file")
+	build := flag.String("build", "", "the build constraint of the generated Go file")
+	flag.Parse()
+
+	log.SetPrefix("gen_breaktest (" + *test + "): ")
 	log.SetFlags(0)
 
+	if *version == "" || *output == "" {
+		log.Fatal("the -version and -output flags are required")
+	}
+	if *build == "" {
+		var err error
+		if *build, err = buildConstraint(*version); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	// Read text of testcases and parse into Go source code.
-	src, err := readAndParse()
+	file, err := readUCD(*version, fmt.Sprintf(testFilePattern, *test))
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := parse(file, fmt.Sprintf(filenamePattern, *test, *version), *variable, *build)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -52,45 +92,40 @@ func main() {
 	}
 
 	// Write it out.
-	if err := ioutil.WriteFile("grapheme_break_test.go", srcfmt, 0644); err != nil {
+	if err := ioutil.WriteFile(*output, srcfmt, 0644); err != nil {
 		log.Fatal(err)
 	}
 }
 
-// readAndParse reads a GraphemeBreakTest text file, either from a local file or
-// from a URL.
-//
-// It parses the file data into Go source code representing the testcases.
-func readAndParse() ([]byte, error) {
-	var r io.ReadCloser
-	if f, err := os.Open(filename); err == nil {
-		log.Printf("using %q", filename)
-		r = f
-	} else if !errors.Is(err, os.ErrNotExist) {
-		return nil, err
-	} else {
-		log.Printf("using %q", url)
-		resp, err := http.Get(url)
-		if err != nil {
-			return nil, err
-		}
-		r = resp.Body
-	}
-	defer r.Close()
+// parse parses the data of a break test text file, whose first line must name
+// the given file name, into Go source code representing the testcases, a slice
+// named "variable". If "build" is not empty, it is added as a build
+// constraint.
+func parse(file *ucdFile, filename, variable, build string) ([]byte, error) {
+	log.Printf("using %q", file.source)
 
 	buf := new(bytes.Buffer)
 	buf.Grow(120 << 10)
 	buf.WriteString(`// Code generated via go generate from gen_breaktest.go. DO NOT EDIT.
-
+`)
+	if build != "" {
+		buf.WriteString(`
+//go:build ` + build + `
+`)
+	}
+	buf.WriteString(`
 package uniseg
 
-// unicodeTestCases are Grapheme testcases taken from
-// ` + url + `,
+// ` + variable + ` are testcases taken from the Unicode Character
+// Database ` + file.version + `,
+//
+//   ` + file.describe("") + `
+//
 // see https://www.unicode.org/license.html for the Unicode license agreement.
-var unicodeTestCases = []testCase {
+var ` + variable + ` = []testCase {
 `)
 
-	sc := bufio.NewScanner(r)
+	sc := bufio.NewScanner(file.reader())
 	num := 1
 	var line []byte
 	if sc.Scan() {
@@ -135,9 +170,10 @@ var unicodeTestCases = []testCase {
 
 // Used by parseRuneSequence to match input via bytes.HasPrefix.
 var (
-	prefix  = []byte("÷ ")
-	breakOk = []byte("÷")
-	breakNo = []byte("×")
+	prefixBreak     = []byte("÷ ")
+	prefixDontBreak = []byte("× ")
+	breakOk         = []byte("÷")
+	breakNo         = []byte("×")
 )
 
 // parseRuneSequence parses a rune + breaking opportunity sequence from b
@@ -152,14 +188,19 @@ var (
 // to orig and exp respectively.
 //
 // The formatting of exp is expected to be cleaned up by gofmt or format.Source.
-// Note we explicitly require the sequence to start with ÷ and we implicitly
-// require it to end with ÷.
+// Note we explicitly require the sequence to start with ÷ or × (line break
+// tests never break at the start of the text) and we implicitly require it to
+// end with ÷.
 func parseRuneSequence(b, orig, exp []byte) ([]byte, []byte, error) {
-	// Check for and remove first ÷.
-	if !bytes.HasPrefix(b, prefix) {
-		return nil, nil, fmt.Errorf("expected line to start with %q", prefix)
+	// Check for and remove first ÷ or ×.
+	switch {
+	case bytes.HasPrefix(b, prefixBreak):
+		b = b[len(prefixBreak):]
+	case bytes.HasPrefix(b, prefixDontBreak):
+		b = b[len(prefixDontBreak):]
+	default:
+		return nil, nil, errors.New("expected ÷ or × as first character")
 	}
-	b = b[len(prefix):]
 
 	boundary := true
 	exp = append(exp, "[][]rune{"...)
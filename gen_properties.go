@@ -0,0 +1,504 @@
+//go:build generate
+
+// This program generates a Go file containing Unicode properties for code
+// point ranges, from the Unicode Character Database auxiliary data files. The
+// following flags are supported:
+//
+//   -version   The version of the Unicode Character Database, e.g. "15.0.0".
+//   -property  The name of the Unicode data file, relative to the "ucd"
+//              directory and without extension, e.g.
+//              "auxiliary/GraphemeBreakProperty". May be omitted if -emojis
+//              is provided. If both are omitted, a string constant named
+//              after -variable which holds the version is generated instead.
+//   -emojis    The emoji property to be included from emoji-data.txt, e.g.
+//              "Extended_Pictographic". Optional.
+//   -gencat    Include the General Category (taken from the comments of the
+//              data file) as a fourth column. Optional.
+//   -trie      Instead of a slice of code point ranges, generate a compact
+//              three-stage lookup table (and a direct lookup table for
+//              Latin-1). The table names are prefixed with the -variable
+//              name. Cannot be combined with -gencat. Optional.
+//   -incb      Add the Indic_Conjunct_Break property (taken from
+//              DerivedCoreProperties.txt, Unicode 15.1.0 and later) to the
+//              lookup table. Requires -trie. Optional.
+//   -variable  The name of the slice mapping code points to properties (or of
+//              the version constant).
+//   -output    The name of the generated Go file.
+//   -build     A build constraint for the generated Go file, e.g. "unicode14".
+//              Optional, it is derived from -version by default (see
+//              ucdVersions in gen_ucd.go).
+//
+// The -ucd and -checksums flags of gen_ucd.go are also supported. The output
+// only depends on the contents of the input files, the header records their
+// version and checksums.
+//
+// For each supported Unicode version, one set of files is generated. The
+// newest version is the default, older versions are selected with build tags
+// (see ucdVersions in gen_ucd.go).
+//
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -property auxiliary/GraphemeBreakProperty -emojis Extended_Pictographic -trie -variable grapheme -output graphemeproperties_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -property auxiliary/WordBreakProperty -emojis Extended_Pictographic -variable wordBreakCodePoints -output wordproperties_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -property auxiliary/SentenceBreakProperty -variable sentenceBreakCodePoints -output sentenceproperties_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -property LineBreak -gencat -variable lineBreakCodePoints -output lineproperties_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -property EastAsianWidth -variable eastAsianWidth -output eastasianwidth_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 15.0.0 -emojis Emoji_Presentation -variable emojiPresentation -output emojipresentation_unicode15.go
+//go:generate go run gen_properties.go gen_ucd.go -version 15.0.0 -variable UnicodeVersion -output unicode15.go
+//
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -property auxiliary/GraphemeBreakProperty -emojis Extended_Pictographic -trie -variable grapheme -output graphemeproperties_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -property auxiliary/WordBreakProperty -emojis Extended_Pictographic -variable wordBreakCodePoints -output wordproperties_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -property auxiliary/SentenceBreakProperty -variable sentenceBreakCodePoints -output sentenceproperties_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -property LineBreak -gencat -variable lineBreakCodePoints -output lineproperties_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -property EastAsianWidth -variable eastAsianWidth -output eastasianwidth_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -ucd=$UNISEG_UCD -checksums=$UNISEG_UCD_CHECKSUMS -version 14.0.0 -emojis Emoji_Presentation -variable emojiPresentation -output emojipresentation_unicode14.go
+//go:generate go run gen_properties.go gen_ucd.go -version 14.0.0 -variable UnicodeVersion -output unicode14.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The regular expression for a line containing a code point range property.
+var propertyPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z0-9_]+)\s*#\s(.+)$`)
+
+// The regular expression for a line of DerivedCoreProperties.txt containing an
+// Indic_Conjunct_Break value.
+var incbPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(\.\.([0-9A-F]{4,6}))?\s*;\s*InCB\s*;\s*([A-Za-z]+)\s*#\s*(.*)$`)
+
+func main() {
+	version := flag.String("version", "", "the version of the Unicode Character Database")
+	property := flag.String("property", "", "the name of the Unicode data file, without extension")
+	emojis := flag.String("emojis", "", "the emoji property to include, if any")
+	gencat := flag.Bool("gencat", false, "include the general category")
+	trie := flag.Bool("trie", false, "generate a three-stage lookup table")
+	incb := flag.Bool("incb", false, "include the Indic_Conjunct_Break property")
+	variable := flag.String("variable", "", "the name of the generated slice")
+	output := flag.String("output", "", "the name of the generated Go file")
+	build := flag.String("build", "", "the build constraint of the generated Go file")
+	flag.Parse()
+
+	log.SetPrefix("gen_properties (" + *variable + "): ")
+	log.SetFlags(0)
+
+	if *version == "" || *variable == "" || *output == "" {
+		log.Fatal("the -version, -variable, and -output flags are required")
+	}
+	if *gencat && *trie {
+		log.Fatal("the -gencat and -trie flags cannot be combined")
+	}
+	if *incb && !*trie {
+		log.Fatal("the -incb flag requires -trie")
+	}
+	if *build == "" {
+		var err error
+		if *build, err = buildConstraint(*version); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Read the text files. We want to generate tables for specific versions
+	// rather than the latest.
+	var (
+		propertyFile, emojiFile, incbFile *ucdFile
+		err                               error
+	)
+	if *property != "" {
+		if propertyFile, err = readUCD(*version, *property+".txt"); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *emojis != "" {
+		if emojiFile, err = readUCD(*version, "emoji/emoji-data.txt"); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *incb {
+		if incbFile, err = readUCD(*version, "DerivedCoreProperties.txt"); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Parse them and generate Go source code.
+	var src string
+	if propertyFile == nil && emojiFile == nil {
+		src = versionConstant(*version, *variable, *build)
+	} else if src, err = parse(propertyFile, emojiFile, *emojis, incbFile, *variable, *build, *gencat, *trie); err != nil {
+		log.Fatal(err)
+	}
+
+	// Format the Go code.
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatal("gofmt:", err)
+	}
+
+	// Save it to the (local) target file.
+	log.Print("Writing to ", *output)
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// versionConstant returns the Go source code of a constant named "variable"
+// which holds the given Unicode version. If "build" is not empty, it is added
+// as a build constraint.
+func versionConstant(version, variable, build string) string {
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated via go generate from gen_properties.go. DO NOT EDIT.
+`)
+	if build != "" {
+		buf.WriteString(`
+//go:build ` + build + `
+`)
+	}
+	buf.WriteString(`
+package uniseg
+
+// ` + variable + ` is the version of the Unicode Standard whose character
+// properties are used by this package. It is selected with build tags, see the
+// package documentation for details.
+const ` + variable + ` = "` + version + `"
+`)
+	return buf.String()
+}
+
+// parse parses the Unicode properties text file (if not nil) and, if
+// "emojiProperty" is not empty, the code points with that property from the
+// emoji data file. It returns their equivalent
+// Go source code, a slice named "variable", to be used in the uniseg package.
+// If "build" is not empty, it is added as a build constraint. If
+// "includeGeneralCategory" is true, the General Category is extracted from the
+// comments and added as a fourth column. If "trie" is true, a three-stage
+// lookup table is generated instead (see writeTrie()). If "incbFile" is not
+// nil, the Indic_Conjunct_Break property is taken from this derived core
+// properties file and added to the lookup table.
+func parse(propertyFile, emojiFile *ucdFile, emojiProperty string, incbFile *ucdFile, variable, build string, includeGeneralCategory, trie bool) (string, error) {
+	// Temporary buffer to hold properties.
+	var properties, incb [][4]string
+
+	// Parse the first file.
+	if propertyFile != nil {
+		log.Printf("Parsing %s", propertyFile.source)
+		scanner := bufio.NewScanner(propertyFile.reader())
+		num := 0
+		for scanner.Scan() {
+			num++
+			line := scanner.Text()
+
+			// Skip comments and empty lines.
+			if strings.HasPrefix(line, "#") || line == "" {
+				continue
+			}
+
+			// Everything else must be a code point range, a property and a comment.
+			from, to, property, comment, err := parseProperty(line)
+			if err != nil {
+				return "", fmt.Errorf("properties line %d: %v", num, err)
+			}
+			properties = append(properties, [4]string{from, to, property, comment})
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+	}
+
+	// Parse the second file.
+	if emojiProperty != "" {
+		log.Printf("Parsing %s", emojiFile.source)
+		scanner := bufio.NewScanner(emojiFile.reader())
+		num := 0
+		for scanner.Scan() {
+			num++
+			line := scanner.Text()
+
+			// Skip comments, empty lines, and everything not containing the
+			// requested emoji property.
+			if strings.HasPrefix(line, "#") || line == "" || !strings.Contains(line, emojiProperty) {
+				continue
+			}
+
+			// Everything else must be a code point range, a property and a comment.
+			from, to, property, comment, err := parseProperty(line)
+			if err != nil {
+				return "", fmt.Errorf("emojis line %d: %v", num, err)
+			}
+			properties = append(properties, [4]string{from, to, property, comment})
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+	}
+
+	// Parse the third file.
+	if incbFile != nil {
+		log.Printf("Parsing %s", incbFile.source)
+		scanner := bufio.NewScanner(incbFile.reader())
+		num := 0
+		for scanner.Scan() {
+			num++
+			line := scanner.Text()
+
+			// Skip comments, empty lines, and all other derived properties.
+			if strings.HasPrefix(line, "#") || line == "" || !strings.Contains(line, "; InCB") {
+				continue
+			}
+
+			fields := incbPattern.FindStringSubmatch(line)
+			if fields == nil {
+				return "", fmt.Errorf("InCB line %d: no property found", num)
+			}
+			from, to := fields[1], fields[3]
+			if to == "" {
+				to = from
+			}
+			incb = append(incb, [4]string{from, to, fields[4], fields[5]})
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+	}
+
+	// Sort properties.
+	sort.SliceStable(properties, func(i, j int) bool {
+		left, _ := strconv.ParseUint(properties[i][0], 16, 64)
+		right, _ := strconv.ParseUint(properties[j][0], 16, 64)
+		return left < right
+	})
+
+	// Header.
+	var buf bytes.Buffer
+	columns := 3
+	if includeGeneralCategory {
+		columns = 4
+	}
+	subject := variable + ` are`
+	if trie {
+		subject = `The ` + variable + ` lookup tables are`
+	}
+	buf.WriteString(`// Code generated via go generate from gen_properties.go. DO NOT EDIT.
+`)
+	if build != "" {
+		buf.WriteString(`
+//go:build ` + build + `
+`)
+	}
+	var (
+		version string
+		sources []string
+	)
+	if propertyFile != nil {
+		version = propertyFile.version
+		sources = append(sources, propertyFile.describe(""))
+	}
+	if emojiProperty != "" {
+		version = emojiFile.version
+		sources = append(sources, emojiFile.describe(`("`+emojiProperty+`" only)`))
+	}
+	if incbFile != nil {
+		sources = append(sources, incbFile.describe(`("InCB" only)`))
+	}
+	buf.WriteString(`
+package uniseg
+
+// ` + subject + ` taken from the
+// following files of the Unicode Character Database ` + version + `:
+//
+//   ` + strings.Join(sources, "\n//   ") + `
+//
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+`)
+	if trie {
+		writeTrie(&buf, properties, incb, variable)
+		return buf.String(), nil
+	}
+	buf.WriteString(`var ` + variable + ` = [][` + strconv.Itoa(columns) + `]int{
+`)
+
+	// Properties.
+	for _, prop := range properties {
+		if includeGeneralCategory {
+			generalCategory := "gc" + prop[3][:2]
+			if generalCategory == "gcL&" {
+				generalCategory = "gcLC"
+			}
+			fmt.Fprintf(&buf, "{0x%s,0x%s,%s,%s}, // %s\n", prop[0], prop[1], translateProperty("pr", prop[2]), generalCategory, prop[3][3:])
+		} else {
+			fmt.Fprintf(&buf, "{0x%s,0x%s,%s}, // %s\n", prop[0], prop[1], translateProperty("pr", prop[2]), prop[3])
+		}
+	}
+
+	// Tail.
+	buf.WriteString("}")
+
+	return buf.String(), nil
+}
+
+// The number of bits of a code point used to index the second and the third
+// stage of the lookup table generated by writeTrie(). The remaining upper bits
+// index the first stage. This must match the lookup in properties.go.
+const trieBlockBits = 6
+
+// writeTrie writes a three-stage lookup table for the given properties to the
+// buffer. A code point's property is found as follows, with the top bits of the
+// code point indexing the first stage (Stage1), the next trieBlockBits bits
+// indexing a block of the second stage (Stage2), and the lowest trieBlockBits
+// bits indexing a block of the third stage (Stage3):
+//
+//   block := Stage1[r>>(2*trieBlockBits)]<<trieBlockBits | r>>trieBlockBits&mask
+//   property := Stage3[Stage2[block]<<trieBlockBits | r&mask]
+//
+// Identical blocks are stored only once. The element types are the smallest
+// unsigned integers which can hold the block indices. In addition, a direct
+// lookup table (Latin1) is generated for the first 256 code points.
+//
+// The Indic_Conjunct_Break values in "incb", if any, are stored in the bits
+// above the property (see incbMask in properties.go).
+func writeTrie(buf *bytes.Buffer, properties, incb [][4]string, variable string) {
+	const blockSize = 1 << trieBlockBits
+
+	// Expand the ranges.
+	codePoints := make([]string, 0x110000)
+	for i := range codePoints {
+		codePoints[i] = "prAny"
+	}
+	for _, prop := range properties {
+		from, _ := strconv.ParseUint(prop[0], 16, 64)
+		to, _ := strconv.ParseUint(prop[1], 16, 64)
+		for r := from; r <= to; r++ {
+			codePoints[r] = translateProperty("pr", prop[2])
+		}
+	}
+	for _, prop := range incb {
+		from, _ := strconv.ParseUint(prop[0], 16, 64)
+		to, _ := strconv.ParseUint(prop[1], 16, 64)
+		for r := from; r <= to; r++ {
+			codePoints[r] += "|incb" + prop[2]
+		}
+	}
+
+	// Deduplicate the third stage blocks.
+	var (
+		stage2, stage1 []int
+		stage3         [][]string
+	)
+	leaves := make(map[string]int)
+	for r := 0; r < len(codePoints); r += blockSize {
+		block := codePoints[r : r+blockSize]
+		key := strings.Join(block, ",")
+		index, ok := leaves[key]
+		if !ok {
+			index = len(stage3)
+			leaves[key] = index
+			stage3 = append(stage3, block)
+		}
+		stage2 = append(stage2, index)
+	}
+
+	// Deduplicate the second stage blocks.
+	var mids [][]int
+	blocks := make(map[string]int)
+	for i := 0; i < len(stage2); i += blockSize {
+		block := stage2[i : i+blockSize]
+		key := fmt.Sprint(block)
+		index, ok := blocks[key]
+		if !ok {
+			index = len(mids)
+			blocks[key] = index
+			mids = append(mids, block)
+		}
+		stage1 = append(stage1, index)
+	}
+
+	// Latin-1.
+	fmt.Fprintf(buf, "\n// %sLatin1 maps the first 256 code points directly to their properties.\n", variable)
+	fmt.Fprintf(buf, "var %sLatin1 = [256]uint8{\n", variable)
+	for r := 0; r < 256; r++ {
+		fmt.Fprintf(buf, "%s,", codePoints[r])
+		if r%8 == 7 {
+			fmt.Fprintf(buf, " // 0x%02X-0x%02X\n", r-7, r)
+		}
+	}
+	buf.WriteString("}\n")
+
+	// First stage.
+	fmt.Fprintf(buf, "\n// %sStage1 maps the upper bits of a code point to a block in %sStage2.\n", variable, variable)
+	fmt.Fprintf(buf, "var %sStage1 = [%d]%s{\n", variable, len(stage1), trieType(len(mids)))
+	for i, index := range stage1 {
+		fmt.Fprintf(buf, "%d,", index)
+		if i%16 == 15 {
+			buf.WriteString("\n")
+		}
+	}
+	buf.WriteString("}\n")
+
+	// Second stage.
+	fmt.Fprintf(buf, "\n// %sStage2 maps the middle bits of a code point to a block in %sStage3.\n", variable, variable)
+	fmt.Fprintf(buf, "var %sStage2 = [%d]%s{\n", variable, len(mids)*blockSize, trieType(len(stage3)))
+	for blockIndex, block := range mids {
+		fmt.Fprintf(buf, "// Block %d.\n", blockIndex)
+		for i, index := range block {
+			fmt.Fprintf(buf, "%d,", index)
+			if i%16 == 15 {
+				buf.WriteString("\n")
+			}
+		}
+	}
+	buf.WriteString("}\n")
+
+	// Third stage.
+	fmt.Fprintf(buf, "\n// %sStage3 maps the lowest bits of a code point to its property.\n", variable)
+	fmt.Fprintf(buf, "var %sStage3 = [%d]uint8{\n", variable, len(stage3)*blockSize)
+	for blockIndex, block := range stage3 {
+		fmt.Fprintf(buf, "// Block %d.\n", blockIndex)
+		for i, prop := range block {
+			fmt.Fprintf(buf, "%s,", prop)
+			if i%8 == 7 {
+				buf.WriteString("\n")
+			}
+		}
+	}
+	buf.WriteString("}\n")
+}
+
+// trieType returns the smallest unsigned integer type which can hold indices
+// into a table with the given number of blocks.
+func trieType(blocks int) string {
+	if blocks <= 1<<8 {
+		return "uint8"
+	}
+	return "uint16"
+}
+
+// parseProperty parses a line of a Unicode properties text file containing a
+// property for a code point range and returns it along with its comment.
+func parseProperty(line string) (from, to, property, comment string, err error) {
+	fields := propertyPattern.FindStringSubmatch(line)
+	if fields == nil {
+		err = errors.New("no property found")
+		return
+	}
+	from = fields[1]
+	to = fields[3]
+	if to == "" {
+		to = from
+	}
+	property = fields[4]
+	comment = fields[5]
+	return
+}
+
+// translateProperty translates a property name as used in the Unicode data file
+// to a variable used in the Go code.
+func translateProperty(prefix, property string) string {
+	return prefix + strings.ReplaceAll(property, "_", "")
+}
@@ -0,0 +1,224 @@
+//go:build generate
+
+package main
+
+// This file contains the code shared by the generators to read files of the
+// Unicode Character Database (UCD). It must be passed to "go run" along with the
+// generator. The following flags are added:
+//
+//   -ucd        A local copy of the Unicode Character Database. Either a
+//               directory laid out like https://www.unicode.org/Public/ (i.e.
+//               the files are found in "<version>/ucd/") or the UCD.zip file
+//               of the requested version. If empty, the files are downloaded
+//               from https://www.unicode.org/Public/. Optional.
+//   -checksums  A file with the SHA-256 checksums of the UCD files, in the
+//               format of the "sha256sum" tool, with paths relative to the
+//               "Public" directory, e.g.:
+//
+//               <hex digits>  15.0.0/ucd/auxiliary/GraphemeBreakProperty.txt
+//
+//               If provided, every file read must be listed with a matching
+//               checksum. Optional.
+//
+// The go:generate directives pass the environment variables UNISEG_UCD and
+// UNISEG_UCD_CHECKSUMS to these flags so offline builds can regenerate the
+// tables, e.g. with:
+//
+//   UNISEG_UCD=/path/to/Public UNISEG_UCD_CHECKSUMS=ucd.sha256 go generate
+//
+// The file ucd.sha256 lists the checksums of the UCD files which the generated
+// files were created from. It must be extended when a version is added.
+//
+// The build constraints of the generated files are derived from ucdVersions
+// below.
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ucdVersions lists the supported Unicode versions, the default version first,
+// along with the build tags which select them. Files generated for the default
+// version are excluded by the tags of all other versions, its own tag is only
+// used once a newer default version is added. To add one, insert it at the top,
+// add its go:generate directives, and run "go generate".
+var ucdVersions = []struct {
+	version string
+	tag     string
+}{
+	{"15.0.0", "unicode15"},
+	{"14.0.0", "unicode14"},
+}
+
+// ucdURL is the location of the UCD files. The version and the file name
+// (relative to the "ucd" directory) are inserted.
+const ucdURL = `https://www.unicode.org/Public/%s/ucd/%s`
+
+var (
+	ucdSource    = flag.String("ucd", "", "a local copy of the Unicode Character Database (directory or UCD.zip)")
+	ucdChecksums = flag.String("checksums", "", "a file with SHA-256 checksums of the UCD files")
+)
+
+// ucdFile is a file of the Unicode Character Database which was read into
+// memory.
+type ucdFile struct {
+	version string // The UCD version, e.g. "15.0.0".
+	name    string // Relative to the "ucd" directory, e.g. "emoji/emoji-data.txt".
+	source  string // The URL or local path the file was read from.
+	data    []byte
+	sha256  string // Hexadecimal.
+}
+
+// readUCD reads the UCD file with the given name (relative to the "ucd"
+// directory) for the given version, from the location given by the -ucd flag
+// or, if that is empty, from https://www.unicode.org/Public/. If the -checksums
+// flag is provided, the file's checksum is verified.
+func readUCD(version, name string) (*ucdFile, error) {
+	file := &ucdFile{
+		version: version,
+		name:    name,
+	}
+
+	// Read the file.
+	var err error
+	switch {
+	case *ucdSource == "":
+		file.source = fmt.Sprintf(ucdURL, version, name)
+		file.data, err = download(file.source)
+	case strings.HasSuffix(strings.ToLower(*ucdSource), ".zip"):
+		file.source = *ucdSource + ":" + name
+		file.data, err = readZip(*ucdSource, name)
+	default:
+		file.source = filepath.Join(*ucdSource, version, "ucd", filepath.FromSlash(name))
+		file.data, err = ioutil.ReadFile(file.source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(file.data)
+	file.sha256 = hex.EncodeToString(sum[:])
+
+	// Verify the checksum.
+	if *ucdChecksums != "" {
+		expected, err := lookupChecksum(*ucdChecksums, path.Join(version, "ucd", name))
+		if err != nil {
+			return nil, err
+		}
+		if file.sha256 != expected {
+			return nil, fmt.Errorf("checksum mismatch for %s: got %s, expected %s", file.source, file.sha256, expected)
+		}
+	}
+
+	return file, nil
+}
+
+// download returns the contents of the file at the given URL.
+func download(url string) ([]byte, error) {
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, res.Status)
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+// readZip returns the contents of the file with the given name from the zip
+// file with the given path. The file may also be located in a "ucd" directory
+// inside the zip file.
+func readZip(zipPath, name string) ([]byte, error) {
+	archive, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	for _, f := range archive.File {
+		if f.Name != name && f.Name != "ucd/"+name {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	}
+
+	return nil, fmt.Errorf("%s: file %s not found", zipPath, name)
+}
+
+// lookupChecksum returns the SHA-256 checksum listed for the file with the
+// given name in the checksums file with the given path.
+func lookupChecksum(checksumsPath, name string) (string, error) {
+	f, err := os.Open(checksumsPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if strings.TrimPrefix(fields[1], "*") == name { // "*" marks binary mode.
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("%s: no checksum for %s", checksumsPath, name)
+}
+
+// buildConstraint returns the build constraint of files generated for the
+// given Unicode version (see ucdVersions).
+func buildConstraint(version string) (string, error) {
+	for index, v := range ucdVersions {
+		if v.version != version {
+			continue
+		}
+		if index > 0 {
+			return v.tag, nil
+		}
+		var others []string
+		for _, other := range ucdVersions[1:] {
+			others = append(others, "!"+other.tag)
+		}
+		return strings.Join(others, " && "), nil
+	}
+	return "", fmt.Errorf("unsupported Unicode version %s, see ucdVersions in gen_ucd.go", version)
+}
+
+// reader returns a reader for the file's contents.
+func (f *ucdFile) reader() io.Reader {
+	return bytes.NewReader(f.data)
+}
+
+// describe returns a description of the file for the header of a generated
+// file: its name, the given note (if not empty), and its checksum. The source
+// of the file is not included, so the output does not depend on where the
+// files were read from.
+func (f *ucdFile) describe(note string) string {
+	if note != "" {
+		note = " " + note
+	}
+	return f.name + note + "\n//     SHA-256 " + f.sha256
+}
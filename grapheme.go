@@ -1,6 +1,11 @@
 package uniseg
 
-import "unicode/utf8"
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
 
 // The states of the grapheme cluster parser.
 const (
@@ -15,6 +20,8 @@ const (
 	grExtendedPictographicZWJ
 	grRIOdd
 	grRIEven
+	grInCBConsonant
+	grInCBLinker
 )
 
 // The grapheme cluster parser's breaking instructions.
@@ -37,6 +44,9 @@ const (
 //      from the transition with the lower rule number, prefer (3) if rule numbers
 //      are equal. Stop.
 //   6. Assume grAny and grBoundary.
+//
+// The parser itself doesn't query this map. It is compiled into grTable when
+// the package is initialized.
 var grTransitions = map[[2]int][3]int{
 	// GB5
 	{grAny, prCR}:      {grCR, grBoundary, 50},
@@ -48,7 +58,7 @@ var grTransitions = map[[2]int][3]int{
 	{grControlLF, prAny}: {grAny, grBoundary, 40},
 
 	// GB3.
-	{grCR, prLF}: {grAny, grNoBoundary, 30},
+	{grCR, prLF}: {grControlLF, grNoBoundary, 30},
 
 	// GB6.
 	{grAny, prL}: {grL, grBoundary, 9990},
@@ -79,16 +89,44 @@ var grTransitions = map[[2]int][3]int{
 	{grAny, prPrepend}: {grPrepend, grBoundary, 9990},
 	{grPrepend, prAny}: {grAny, grNoBoundary, 92},
 
-	// GB11.
+	// GB11. Extend and ZWJ are kept by GB9 but they are tracked here.
 	{grAny, prExtendedPictographic}:                     {grExtendedPictographic, grBoundary, 9990},
-	{grExtendedPictographic, prExtend}:                  {grExtendedPictographic, grNoBoundary, 110},
-	{grExtendedPictographic, prZWJ}:                     {grExtendedPictographicZWJ, grNoBoundary, 110},
+	{grExtendedPictographic, prExtend}:                  {grExtendedPictographic, grNoBoundary, 90},
+	{grExtendedPictographic, prZWJ}:                     {grExtendedPictographicZWJ, grNoBoundary, 90},
 	{grExtendedPictographicZWJ, prExtendedPictographic}: {grExtendedPictographic, grNoBoundary, 110},
 
 	// GB12 / GB13.
 	{grAny, prRegionalIndicator}:    {grRIOdd, grBoundary, 9990},
 	{grRIOdd, prRegionalIndicator}:  {grRIEven, grNoBoundary, 120},
-	{grRIEven, prRegionalIndicator}: {grRIOdd, grBoundary, 120},
+	{grRIEven, prRegionalIndicator}: {grRIOdd, grBoundary, 9990},
+}
+
+// The number of grapheme cluster parser states and the number of values in the
+// grapheme property tables (as returned by graphemeValue()).
+const (
+	grNumStates = grInCBLinker + 1
+	grNumValues = incbMask + 1<<4
+)
+
+// grTable is the dense version of grTransitions, compiled at initialization.
+// It maps [state][value] to the new state (shifted left by one bit) and the
+// breaking instruction (in the lowest bit), where "value" is the combination
+// of the grapheme cluster break property and the Indic_Conjunct_Break property
+// of the next code point. The rule numbers are not part of this table, use
+// grRule() to retrieve them.
+var grTable [grNumStates][grNumValues]uint8
+
+func init() {
+	for state := 0; state < grNumStates; state++ {
+		for value := 0; value < grNumValues; value++ {
+			newState, boundary, _ := grRule(state, value)
+			transition := uint8(newState << 1)
+			if boundary {
+				transition |= grBoundary
+			}
+			grTable[state][value] = transition
+		}
+	}
 }
 
 // Graphemes implements an iterator over Unicode extended grapheme clusters,
@@ -98,46 +136,87 @@ var grTransitions = map[[2]int][3]int{
 // woman + ZWJ + heavy black heart (2 code points) + ZWJ + kiss mark + ZWJ +
 // woman) and the rules described in Annex #29 must be applied to group those
 // code points into clusters perceived by the user as one character.
+//
+// Bytes which are not part of a valid UTF-8 encoded code point are treated
+// like control characters, i.e. each of them is a grapheme cluster of its own
+// (see Invalid()). Str(), Bytes(), and Positions() always refer to the
+// original bytes, including invalid ones, while Runes() returns
+// utf8.RuneError (U+FFFD) in their place.
+//
+// An iterator may be reused for any number of strings or byte slices by
+// calling Init() or InitBytes(). The zero value is ready to use after one of
+// these calls, so iterators may be kept on the stack or in a sync.Pool.
 type Graphemes struct {
-	// The code points over which this class iterates.
-	codePoints []rune
+	// The original string.
+	original string
 
-	// The (byte-based) indices of the code points into the original string plus
-	// len(original string). Thus, len(indices) = len(codePoints) + 1.
-	indices []int
+	// The original byte slice, if the iterator was initialized with
+	// InitBytes(). In that case, "original" is not used.
+	originalBytes []byte
 
-	// The current grapheme cluster to be returned. These are indices into
-	// codePoints/indices. If start == end, we either haven't started iterating
-	// yet (0) or the iteration has already completed (1).
+	// The current grapheme cluster to be returned. These are byte positions
+	// into the original text. If start == end, we either haven't started
+	// iterating yet (0) or the iteration has already completed (the length of
+	// the text).
 	start, end int
 
-	// The index of the next code point to be parsed.
+	// The byte position of the next code point to be parsed. The code point
+	// starting at "end" has already been parsed. If pos is larger than the
+	// length of the text, the end of the text has been reached.
 	pos int
 
 	// The current state of the code point parser.
 	state int
 }
 
-// NewGraphemes returns a new grapheme cluster iterator.
+// NewGraphemes returns a new grapheme cluster iterator. The string is decoded
+// as the iterator advances, no memory is allocated for its code points.
 func NewGraphemes(s string) *Graphemes {
-	l := utf8.RuneCountInString(s)
-	codePoints := make([]rune, l)
-	indices := make([]int, l+1)
-	i := 0
-	for pos, r := range s {
-		codePoints[i] = r
-		indices[i] = pos
-		i++
-	}
-	indices[l] = len(s)
-	g := &Graphemes{
-		codePoints: codePoints,
-		indices:    indices,
-	}
-	g.Next() // Parse ahead.
+	g := &Graphemes{}
+	g.Init(s)
 	return g
 }
 
+// Init sets the iterator to the start of the given string, discarding the
+// string it previously iterated over, such that the next call to Next() sets
+// it to the first grapheme cluster of "s". It may be called on the zero value
+// of Graphemes. No memory is allocated.
+func (g *Graphemes) Init(s string) {
+	g.original, g.originalBytes = s, nil
+	g.Reset()
+}
+
+// InitBytes is like Init() but the iterator iterates over the given byte
+// slice. The byte slice is not copied and must not be modified during the
+// iteration. Bytes() then returns subslices of "b" while Str() allocates a new
+// string for each cluster.
+func (g *Graphemes) InitBytes(b []byte) {
+	if b == nil {
+		b = []byte{} // A nil slice would select the string.
+	}
+	g.original, g.originalBytes = "", b
+	g.Reset()
+}
+
+// size returns the length of the original text in bytes.
+func (g *Graphemes) size() int {
+	if g.originalBytes != nil {
+		return len(g.originalBytes)
+	}
+	return len(g.original)
+}
+
+// decodeRune decodes the code point at the given byte position of the original
+// text, returning invalidRune for an invalid byte (see graphemeRune()).
+func (g *Graphemes) decodeRune(pos int) (r rune, length int) {
+	if g.originalBytes != nil {
+		r, length = utf8.DecodeRune(g.originalBytes[pos:])
+	} else {
+		r, length = utf8.DecodeRuneInString(g.original[pos:])
+	}
+	return graphemeRune(r, length), length
+}
+
 // Next advances the iterator by one grapheme cluster and returns false if no
 // clusters are left. This function must be called before the first cluster is
 // accessed.
@@ -148,9 +227,10 @@ func (g *Graphemes) Next() bool {
 	// point so we always need to stay ahead by one code point.
 
 	// Parse the next code point.
-	for g.pos <= len(g.codePoints) {
+	size := g.size()
+	for g.pos <= size {
 		// GB2.
-		if g.pos == len(g.codePoints) {
+		if g.pos == size {
 			g.end = g.pos
 			g.pos++
 			break
@@ -158,34 +238,164 @@ func (g *Graphemes) Next() bool {
 
 		// Calculate the next state.
 		var boundary bool
-		g.state, boundary = transitionGraphemeState(g.state, g.codePoints[g.pos])
+		r, length := g.decodeRune(g.pos)
+		g.state, boundary = transitionGraphemeState(g.state, r)
 
 		// If we found a cluster boundary, let's stop here. The current cluster will
 		// be the one that just ended.
 		if g.pos == 0 /* GB1 */ || boundary {
 			g.end = g.pos
-			g.pos++
+			g.pos += length
 			break
 		}
 
-		g.pos++
+		g.pos += length
 	}
 
 	return g.start != g.end
 }
 
+// Prev moves the iterator back by one grapheme cluster and returns false if no
+// clusters are left before the current one. In that case, the iterator is
+// reset to its initial state, i.e. the next call to Next() will set it to the
+// first grapheme cluster. If the iterator is already past the end, Prev() sets
+// it to the last grapheme cluster. Calls to Next() and Prev() may be mixed.
+func (g *Graphemes) Prev() bool {
+	if g.start == 0 {
+		// We're at the first cluster or haven't started yet.
+		g.Reset()
+		return false
+	}
+
+	// Find the start of the preceding cluster.
+	g.end = g.start
+	if g.originalBytes != nil {
+		_, rest := LastGraphemeCluster(g.originalBytes[:g.start])
+		g.start = len(rest)
+	} else {
+		_, rest := LastGraphemeClusterInString(g.original[:g.start])
+		g.start = len(rest)
+	}
+
+	// The next call to Next() must continue after this cluster.
+	g.parseClusterStart()
+
+	return true
+}
+
+// Seek moves the iterator to the grapheme cluster containing the byte at the
+// given offset into the original string, such that Str(), Runes() etc. return
+// that cluster and Next() and Prev() continue from there. Only the code points
+// required to determine the start of that cluster are examined.
+//
+// If the offset is negative, the iterator is reset to its initial state. If it
+// is at or beyond the end of the string, the iterator is moved past the last
+// cluster, just as if Next() had returned false. In both cases, false is
+// returned.
+func (g *Graphemes) Seek(byteOffset int) bool {
+	if byteOffset < 0 {
+		g.Reset()
+		return false
+	}
+	if size := g.size(); byteOffset >= size {
+		g.start, g.end, g.pos = size, size, size+1
+		return false
+	}
+
+	// Find the first code point of the cluster and parse from there.
+	g.end = graphemeClusterStart(g.originalBytes, g.original, byteOffset)
+	g.parseClusterStart()
+	return g.Next()
+}
+
+// parseClusterStart parses the code point at "end", which must be the start of
+// a grapheme cluster (or the end of the string), such that the next call to
+// Next() continues from there. A cluster start is always a boundary so the
+// state does not depend on anything before it.
+func (g *Graphemes) parseClusterStart() {
+	if size := g.size(); g.end >= size {
+		g.pos = size + 1
+		return
+	}
+	r, length := g.decodeRune(g.end)
+	g.state, _ = transitionGraphemeState(grAny, r)
+	g.pos = g.end + length
+}
+
 // transitionGraphemeState determines the new state of the grapheme cluster
 // parser given the current state and the next code point. It also returns
 // whether a cluster boundary was detected.
 func transitionGraphemeState(state int, r rune) (newState int, boundary bool) {
-	// Determine the property of the next character.
-	nextProperty := property(r)
+	value := prControl // For invalidRune.
+	if r != invalidRune {
+		value = graphemeValue(r)
+	}
+	transition := grTable[state][value]
+	return int(transition >> 1), transition&1 == grBoundary
+}
+
+// invalidRune is passed to the grapheme cluster parser in place of bytes which
+// are not part of a valid UTF-8 encoded code point. The parser treats it like a
+// code point with the grapheme cluster break property Control so each such
+// byte forms a cluster of its own. It has no other properties.
+const invalidRune = -1
+
+// graphemeRune returns the code point to be passed to the grapheme cluster
+// parser for the code point "r" of the given length in bytes, as returned by
+// the decoding functions of the utf8 package: invalidRune for an invalid byte,
+// "r" itself otherwise.
+func graphemeRune(r rune, length int) rune {
+	if r == utf8.RuneError && length == 1 {
+		return invalidRune
+	}
+	return r
+}
+
+// grRule determines the transition of the grapheme cluster parser from the
+// given state for a code point with the given value (see graphemeValue()). It
+// also returns the number of the rule (times 10) that was applied. This
+// function is used to compile grTable and may also be used for debugging.
+//
+// The grapheme cluster break property is handled by grPropertyRule(). GB9c,
+// which is based on the Indic_Conjunct_Break property, is applied on top of
+// it: The states grInCBConsonant and grInCBLinker track a consonant followed
+// by any number of InCB extenders and linkers (at least one for the latter).
+// They behave like grAny otherwise.
+func grRule(state, nextValue int) (newState int, boundary bool, rule int) {
+	newState, boundary, rule = grPropertyRule(state, nextValue&^incbMask)
+
+	switch nextValue & incbMask {
+	case incbConsonant:
+		if state == grInCBLinker {
+			// GB9c: Consonant [Extend Linker]* Linker [Extend Linker]* x Consonant.
+			boundary, rule = false, 93
+		}
+		if newState == grAny {
+			newState = grInCBConsonant
+		}
+	case incbLinker:
+		if state == grInCBConsonant || state == grInCBLinker {
+			newState = grInCBLinker
+		}
+	case incbExtend:
+		if state == grInCBConsonant || state == grInCBLinker {
+			newState = state
+		}
+	}
 
+	return
+}
+
+// grPropertyRule determines the transition of the grapheme cluster parser from
+// the given state for a code point with the given grapheme cluster break
+// property, by querying grTransitions as described there. It also returns the
+// number of the rule (times 10) that was applied.
+func grPropertyRule(state, nextProperty int) (newState int, boundary bool, rule int) {
 	// Find the applicable transition.
 	transition, ok := grTransitions[[2]int{state, nextProperty}]
 	if ok {
 		// We have a specific transition. We'll use it.
-		return transition[0], transition[1] == grBoundary
+		return transition[0], transition[1] == grBoundary, transition[2]
 	}
 
 	// No specific transition found. Try the less specific ones.
@@ -195,15 +405,17 @@ func transitionGraphemeState(state int, r rune) (newState int, boundary bool) {
 		// Both apply. We'll use a mix (see comments for grTransitions).
 		newState = transAnyState[0]
 		boundary = transAnyState[1] == grBoundary
+		rule = transAnyState[2]
 		if transAnyProp[2] < transAnyState[2] {
 			boundary = transAnyProp[1] == grBoundary
+			rule = transAnyProp[2]
 		}
 		return
 	}
 
 	if okAnyProp {
 		// We only have a specific state.
-		return transAnyProp[0], transAnyProp[1] == grBoundary
+		return transAnyProp[0], transAnyProp[1] == grBoundary, transAnyProp[2]
 		// This branch will probably never be reached because okAnyState will
 		// always be true given the current transition map. But we keep it here
 		// for future modifications to the transition map where this may not be
@@ -212,51 +424,93 @@ func transitionGraphemeState(state int, r rune) (newState int, boundary bool) {
 
 	if okAnyState {
 		// We only have a specific property.
-		return transAnyState[0], transAnyState[1] == grBoundary
+		return transAnyState[0], transAnyState[1] == grBoundary, transAnyState[2]
 	}
 
-	// No known transition. GB999: Any x Any.
-	return grAny, true
+	// No known transition. GB999: Any ÷ Any.
+	return grAny, true, 9990
 }
 
 // Runes returns a slice of runes (code points) which corresponds to the current
-// grapheme cluster. If the iterator is already past the end or Next() has not
-// yet been called, nil is returned.
+// grapheme cluster. The slice is allocated with each call. If the iterator is
+// already past the end or Next() has not yet been called, nil is returned.
 func (g *Graphemes) Runes() []rune {
 	if g.start == g.end {
 		return nil
 	}
-	return g.codePoints[g.start:g.end]
+	if g.originalBytes != nil {
+		return bytes.Runes(g.originalBytes[g.start:g.end])
+	}
+	return []rune(g.original[g.start:g.end])
 }
 
 // Str returns a substring of the original string which corresponds to the
 // current grapheme cluster. If the iterator is already past the end or Next()
 // has not yet been called, an empty string is returned.
 func (g *Graphemes) Str() string {
-	if g.start == g.end {
-		return ""
+	if g.originalBytes != nil {
+		return string(g.originalBytes[g.start:g.end])
 	}
-	return string(g.codePoints[g.start:g.end])
+	return g.original[g.start:g.end]
 }
 
 // Bytes returns a byte slice which corresponds to the current grapheme cluster.
-// If the iterator is already past the end or Next() has not yet been called,
-// nil is returned.
+// If the iterator was initialized with InitBytes(), this is a subslice of the
+// original byte slice, otherwise a copy. If the iterator is already past the
+// end or Next() has not yet been called, nil is returned.
 func (g *Graphemes) Bytes() []byte {
 	if g.start == g.end {
 		return nil
 	}
-	return []byte(string(g.codePoints[g.start:g.end]))
+	if g.originalBytes != nil {
+		return g.originalBytes[g.start:g.end:g.end]
+	}
+	return []byte(g.original[g.start:g.end])
+}
+
+// Invalid returns true if the current grapheme cluster is a byte which is not
+// part of a valid UTF-8 encoded code point. Together with Positions(), this can
+// be used to report the locations of encoding errors:
+//
+//   g := uniseg.NewGraphemes(str)
+//   for g.Next() {
+//       if g.Invalid() {
+//           from, _ := g.Positions()
+//           fmt.Printf("Invalid UTF-8 at byte %d\n", from)
+//       }
+//   }
+//
+// If the iterator is already past the end or Next() has not yet been called,
+// false is returned.
+func (g *Graphemes) Invalid() bool {
+	if g.end-g.start != 1 {
+		return false
+	}
+	r, _ := g.decodeRune(g.start)
+	return r == invalidRune
+}
+
+// Width returns the monospace width of the current grapheme cluster, i.e. the
+// number of same-size cells it occupies in a terminal. Most clusters have a
+// width of 1. East Asian wide characters and emoji have a width of 2, control
+// characters a width of 0. If the iterator is already past the end or Next()
+// has not yet been called, 0 is returned.
+func (g *Graphemes) Width() int {
+	if g.originalBytes != nil {
+		return clusterWidth(g.originalBytes[g.start:g.end])
+	}
+	return clusterWidthInString(g.original[g.start:g.end])
 }
 
 // Positions returns the interval of the current grapheme cluster as byte
 // positions into the original string. The first returned value "from" indexes
 // the first byte and the second returned value "to" indexes the first byte that
 // is not included anymore, i.e. str[from:to] is the current grapheme cluster of
-// the original string "str". If Next() has not yet been called, both values are
-// 0. If the iterator is already past the end, both values are 1.
+// the original string "str" (or byte slice). If Next() has not yet been called,
+// both values are 0. If the iterator is already past the end, both values are
+// len(str).
 func (g *Graphemes) Positions() (int, int) {
-	return g.indices[g.start], g.indices[g.end]
+	return g.start, g.end
 }
 
 // Reset puts the iterator into its initial state such that the next call to
@@ -268,108 +522,557 @@ func (g *Graphemes) Reset() {
 
 // GraphemeClusterCount returns the number of user-perceived characters
 // (grapheme clusters) for the given string. To calculate this number, it
-// iterates through the string using the Graphemes iterator.
+// iterates through the string using FirstGraphemeClusterInString(), without
+// allocating any memory.
 func GraphemeClusterCount(s string) (n int) {
-	g := NewGraphemes(s)
-	for g.Next() {
+	var state GraphemeState
+	for len(s) > 0 {
+		_, s, _, state = FirstGraphemeClusterInString(s, state)
 		n++
 	}
 	return
 }
 
-// firstGraphemeCluster returns the first grapheme cluster (as a slice of bytes)
+// GraphemeState holds the state of the grapheme cluster parser between calls
+// to FirstGraphemeCluster() or FirstGraphemeClusterInString(). Its zero value
+// represents an unknown state, which is what must be passed when parsing
+// starts. Its fields are internal and may change in future versions.
+type GraphemeState struct {
+	// The state of the code point parser after the first code point of the next
+	// grapheme cluster has been processed.
+	state int
+
+	// Whether or not "state" contains a valid parser state.
+	valid bool
+}
+
+// FirstGraphemeCluster returns the first grapheme cluster (as a slice of bytes)
 // found in the given byte slice. This function can be called continuously to
 // extract all grapheme clusters from a byte slice, as follows:
 //
-//   state := -1
+//   var state uniseg.GraphemeState
 //   for len(b) > 0 {
-//       c, b, state = firstGraphemeCluster(b, state)
+//       c, b, _, state = uniseg.FirstGraphemeCluster(b, state)
 //       // Do something with c.
 //   }
 //
 // If you don't know the current state, for example when calling the function
-// for the first time, you must pass -1. Always passing -1 will work but it will
-// slow down the function. For consecutive calls, you should pass the state
-// returned by the previous call.
+// for the first time, you must pass the zero value of GraphemeState. For
+// consecutive calls, you should pass the state returned by the previous call.
 //
 // The "rest" slice is the subslice of the original byte slice "b" starting
 // after the last byte of the identified grapheme cluster. If the length of the
 // "rest" slice is 0, the entire byte slice "b" has been processed.
 //
+// The "boundary" flag is true if the end of the cluster is a confirmed
+// grapheme cluster boundary. It is false if the cluster extends to the end of
+// "b" and could still be continued by code points following "b", for example
+// a combining mark or a zero width joiner. This is only relevant when "b" is
+// part of a larger text which is not yet available in its entirety.
+//
 // For an empty byte slice "b", the function returns nil values.
 //
 // Using this function is the preferred method of extracting grapheme clusters
 // when working exclusively with byte slices and/or with large byte slices, as
 // no large allocations are made.
-//
-// For the time being, this function is private because its signature might
-// still change.
-func firstGraphemeCluster(b []byte, state int) (cluster, rest []byte, newState int) {
+func FirstGraphemeCluster(b []byte, state GraphemeState) (cluster, rest []byte, boundary bool, newState GraphemeState) {
 	// An empty byte slice returns nothing.
 	if len(b) == 0 {
 		return
 	}
 
-	// Extract the first rune.
+	// Extract the first rune. If we don't know the state, determine it now.
 	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
-		return b, nil, grAny
+	s := state.state
+	if !state.valid {
+		s, _ = transitionGraphemeState(grAny, graphemeRune(r, length))
 	}
-
-	// If we don't know the state, determine it now.
-	if state < 0 {
-		state, _ = transitionGraphemeState(grAny, r)
+	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
+		return b, nil, s == grControlLF, GraphemeState{}
 	}
 
 	// Transition until we find a boundary.
-	var boundary bool
 	for {
 		r, l := utf8.DecodeRune(b[length:])
-		state, boundary = transitionGraphemeState(state, r)
+		s, boundary = transitionGraphemeState(s, graphemeRune(r, l))
 
 		if boundary {
-			return b[:length], b[length:], state
+			return b[:length], b[length:], true, GraphemeState{state: s, valid: true}
 		}
 
 		length += l
 		if len(b) <= length {
-			return b, nil, grAny
+			return b, nil, s == grControlLF, GraphemeState{}
 		}
 	}
 }
 
-// firstGraphemeClusterInString is like firstGraphemeCluster() but its input and
-// outputs are a string.
-func firstGraphemeClusterInString(str string, state int) (cluster, rest string, newState int) {
+// FirstGraphemeClusterInString is like FirstGraphemeCluster() but its input
+// and outputs are strings.
+func FirstGraphemeClusterInString(str string, state GraphemeState) (cluster, rest string, boundary bool, newState GraphemeState) {
 	// An empty string returns nothing.
 	if len(str) == 0 {
 		return
 	}
 
-	// Extract the first rune.
+	// Extract the first rune. If we don't know the state, determine it now.
 	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		return str, "", grAny
+	s := state.state
+	if !state.valid {
+		s, _ = transitionGraphemeState(grAny, graphemeRune(r, length))
 	}
-
-	// If we don't know the state, determine it now.
-	if state < 0 {
-		state, _ = transitionGraphemeState(grAny, r)
+	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
+		return str, "", s == grControlLF, GraphemeState{}
 	}
 
 	// Transition until we find a boundary.
-	var boundary bool
 	for {
 		r, l := utf8.DecodeRuneInString(str[length:])
-		state, boundary = transitionGraphemeState(state, r)
+		s, boundary = transitionGraphemeState(s, graphemeRune(r, l))
 
 		if boundary {
-			return str[:length], str[length:], state
+			return str[:length], str[length:], true, GraphemeState{state: s, valid: true}
 		}
 
 		length += l
 		if len(str) <= length {
-			return str, "", grAny
+			return str, "", s == grControlLF, GraphemeState{}
+		}
+	}
+}
+
+// TraceGraphemes explains the grapheme cluster boundaries of the given string.
+// It returns the string's code points (in hexadecimal), each preceded by the
+// decision for the position before it, in the notation of the Unicode test file
+// GraphemeBreakTest.txt: "÷" for a boundary and "×" for no boundary, followed
+// by the number of the rule of Unicode Standard Annex #29 which applied, e.g.:
+//
+//   ÷ [0.2] 0065 × [9.0] 0301 ÷ [999.0] 0021 ÷ [0.3]
+//
+// Here, [0.2] and [0.3] denote the start and the end of the text (GB1 and GB2)
+// and [999.0] means that no other rule applied (GB999). Invalid UTF-8 bytes
+// are shown as FFFD but, like control characters, they are separated from
+// their neighbors by GB4 and GB5. An empty string results in an empty string.
+//
+// This function is meant for debugging. It is much slower than the other
+// functions of this package.
+func TraceGraphemes(s string) string {
+	var (
+		b      strings.Builder
+		state  int
+		onlyRI = true // Whether all code points so far were regional indicators.
+	)
+	for index := 0; index < len(s); {
+		r, length := utf8.DecodeRuneInString(s[index:])
+		value := prControl
+		if graphemeRune(r, length) != invalidRune {
+			value = graphemeValue(r)
+		}
+		if index == 0 {
+			state, _, _ = grRule(grAny, value)
+			b.WriteString("÷ [0.2]")
+		} else {
+			var (
+				boundary bool
+				rule     int
+			)
+			state, boundary, rule = grRule(state, value)
+			if rule == 120 && !onlyRI {
+				rule = 130 // GB13 instead of GB12 if not at the start of the text.
+			}
+			symbol := "×"
+			if boundary {
+				symbol = "÷"
+			}
+			fmt.Fprintf(&b, " %s [%d.%d]", symbol, rule/10, rule%10)
+		}
+		if value&^incbMask != prRegionalIndicator {
+			onlyRI = false
+		}
+		fmt.Fprintf(&b, " %04X", r)
+		index += length
+	}
+	if len(s) > 0 {
+		b.WriteString(" ÷ [0.3]")
+	}
+	return b.String()
+}
+
+// Reverse reverses the order of the grapheme clusters in the given string,
+// keeping the code points of each cluster in their original order. Reversing
+// the code points alone would, for example, swap the regional indicators of a
+// flag or move combining marks to a different base character.
+func Reverse(s string) string {
+	reversed := make([]byte, len(s))
+	var (
+		cluster string
+		state   GraphemeState
+	)
+	for len(s) > 0 {
+		cluster, s, _, state = FirstGraphemeClusterInString(s, state)
+		copy(reversed[len(s):], cluster)
+	}
+	return string(reversed)
+}
+
+// ScanGraphemes is a split function for a bufio.Scanner that returns each
+// grapheme cluster as a token, as follows:
+//
+//   scanner := bufio.NewScanner(reader)
+//   scanner.Split(uniseg.ScanGraphemes)
+//   for scanner.Scan() {
+//       // Do something with scanner.Text() or scanner.Bytes().
+//   }
+//
+// A token is only returned if the boundary after it has been confirmed or if
+// the end of the input has been reached. If a cluster could still be continued
+// by data not yet read, e.g. if the buffer ends with a zero width joiner, a
+// combining mark, or an incomplete UTF-8 sequence, more data is requested.
+// Each invalid UTF-8 byte is returned as a token of its own, unaltered.
+func ScanGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	// Ignore an incomplete UTF-8 sequence at the end of the buffer, it will be
+	// completed by the data to come.
+	if !atEOF {
+		data = trimIncompleteRune(data)
+	}
+	if len(data) == 0 {
+		return 0, nil, nil // Request more data or, at EOF, stop.
+	}
+
+	cluster, rest, boundary, _ := FirstGraphemeCluster(data, GraphemeState{})
+	if len(rest) > 0 {
+		// The boundary was confirmed by the first code point of "rest".
+		return len(cluster), cluster, nil
+	}
+
+	// The cluster extends to the end of the buffer.
+	if atEOF || boundary {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// trimIncompleteRune removes an incomplete UTF-8 sequence from the end of the
+// byte slice, if there is one.
+func trimIncompleteRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+// LastGraphemeCluster returns the last grapheme cluster found in the given byte
+// slice, according to the rules of Unicode Standard Annex #29, Grapheme
+// Cluster Boundaries. This function can be called continuously to extract all
+// grapheme clusters from a byte slice in reverse order, as follows:
+//
+//   for len(b) > 0 {
+//       c, b = uniseg.LastGraphemeCluster(b)
+//       // Do something with c.
+//   }
+//
+// The "rest" slice is the subslice of the original byte slice "b" preceding the
+// identified grapheme cluster, i.e. b[:len(rest)]. If the length of the "rest"
+// slice is 0, the entire byte slice "b" has been processed. It is assumed that
+// "b" ends at a grapheme cluster boundary.
+//
+// For an empty byte slice "b", the function returns nil values.
+//
+// Because the function only scans backwards as far as needed, this is the
+// preferred method for editing operations at the end of a text, e.g. deleting
+// the last character.
+func LastGraphemeCluster(b []byte) (cluster, rest []byte) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
+		return
+	}
+
+	// Move backwards until we find a boundary.
+	r, length := lastGraphemeRune(b, "")
+	start := len(b) - length
+	for start > 0 {
+		boundary, prev, l := graphemeBoundaryBefore(r, b[:start], "")
+		if boundary {
+			break
+		}
+		start -= l
+		r = prev
+	}
+
+	return b[start:], b[:start]
+}
+
+// LastGraphemeClusterInString is like LastGraphemeCluster() but its input and
+// outputs are strings.
+func LastGraphemeClusterInString(str string) (cluster, rest string) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
+	}
+
+	// Move backwards until we find a boundary.
+	r, length := lastGraphemeRune(nil, str)
+	start := len(str) - length
+	for start > 0 {
+		boundary, prev, l := graphemeBoundaryBefore(r, nil, str[:start])
+		if boundary {
+			break
+		}
+		start -= l
+		r = prev
+	}
+
+	return str[start:], str[:start]
+}
+
+// graphemeBoundaryBefore determines whether there is a grapheme cluster
+// boundary between the last code point of the byte slice or the string
+// (whichever is not nil or empty) and the code point "next" which follows it.
+// It also returns that last code point and its length in bytes.
+//
+// The rules of Annex #29 only ever look at two adjacent code points, with three
+// exceptions which require scanning further backwards: Indic conjuncts (GB9c),
+// emoji ZWJ sequences (GB11), and regional indicator pairs (GB12, GB13).
+func graphemeBoundaryBefore(next rune, b []byte, str string) (boundary bool, prev rune, length int) {
+	prev, length = lastGraphemeRune(b, str)
+	b, str = trimGraphemeRune(b, str, length)
+	prevProperty := graphemeProperty(prev)
+	nextProperty := graphemeProperty(next)
+
+	// GB11: ExtPict Extend* ZWJ x ExtPict.
+	if prevProperty == prZWJ && nextProperty == prExtendedPictographic {
+		for {
+			r, l := lastGraphemeRune(b, str)
+			if l == 0 {
+				return true, prev, length
+			}
+			b, str = trimGraphemeRune(b, str, l)
+			switch graphemeProperty(r) {
+			case prExtend:
+				continue
+			case prExtendedPictographic:
+				return false, prev, length
+			}
+			return true, prev, length
+		}
+	}
+
+	// GB9c: Consonant [Extend Linker]* Linker [Extend Linker]* x Consonant.
+	if graphemeValue(next)&incbMask == incbConsonant {
+		linker := graphemeValue(prev) & incbMask
+		if linker == incbLinker || linker == incbExtend {
+			for {
+				r, l := lastGraphemeRune(b, str)
+				if l == 0 {
+					break
+				}
+				b, str = trimGraphemeRune(b, str, l)
+				incb := graphemeValue(r) & incbMask
+				if incb == incbLinker {
+					linker = incbLinker
+					continue
+				}
+				if incb == incbExtend {
+					continue
+				}
+				if incb == incbConsonant && linker == incbLinker {
+					return false, prev, length
+				}
+				break
+			}
+		}
+	}
+
+	// GB12, GB13: Count the regional indicators preceding "prev". There is no
+	// boundary if "prev" is the first of a pair.
+	if prevProperty == prRegionalIndicator && nextProperty == prRegionalIndicator {
+		var count int
+		for {
+			r, l := lastGraphemeRune(b, str)
+			if l == 0 || graphemeProperty(r) != prRegionalIndicator {
+				break
+			}
+			b, str = trimGraphemeRune(b, str, l)
+			count++
+		}
+		return count%2 == 1, prev, length
+	}
+
+	// All other rules only depend on the two code points. The state after the
+	// first code point does not depend on anything before it.
+	state, _ := transitionGraphemeState(grAny, prev)
+	_, boundary = transitionGraphemeState(state, next)
+	return boundary, prev, length
+}
+
+// lastGraphemeRune decodes the last code point of the byte slice or the string
+// (whichever is not nil or empty), returning invalidRune for an invalid byte
+// (see graphemeRune()). Its length is 0 if both are empty.
+func lastGraphemeRune(b []byte, str string) (r rune, length int) {
+	if b != nil {
+		r, length = utf8.DecodeLastRune(b)
+	} else {
+		r, length = utf8.DecodeLastRuneInString(str)
+	}
+	return graphemeRune(r, length), length
+}
+
+// trimGraphemeRune removes the last "length" bytes from the byte slice or the
+// string (whichever is not nil or empty).
+func trimGraphemeRune(b []byte, str string, length int) ([]byte, string) {
+	if b != nil {
+		return b[:len(b)-length], str
+	}
+	return nil, str[:len(str)-length]
+}
+
+// IsGraphemeBoundary returns true if there is a grapheme cluster boundary at
+// the given byte offset of the string, i.e. between s[:offset] and s[offset:].
+// The start and the end of the string are always boundaries (GB1, GB2). An
+// offset pointing into the middle of a UTF-8 encoded code point is never a
+// boundary.
+//
+// Unlike iterating over the string from the beginning, this function only
+// looks at the code points around the offset, scanning backwards only as far
+// as the rules for Indic conjuncts, emoji ZWJ sequences, and regional indicator
+// pairs require.
+func IsGraphemeBoundary(s string, offset int) bool {
+	if offset <= 0 || offset >= len(s) {
+		return true
+	}
+	if runeStart(nil, s, offset) != offset {
+		return false // Inside a code point.
+	}
+	next, length := utf8.DecodeRuneInString(s[offset:])
+	boundary, _, _ := graphemeBoundaryBefore(graphemeRune(next, length), nil, s[:offset])
+	return boundary
+}
+
+// SnapGraphemeBoundaries adjusts the byte range [start, end) of the string so
+// that both ends fall on grapheme cluster boundaries. This is useful when
+// highlighting search results or selections which were found on a byte or
+// code point basis.
+//
+// If "outward" is true, the range is extended to include every grapheme
+// cluster it touches. Otherwise, it is shrunk to the grapheme clusters it
+// fully contains. If the range does not fully contain any grapheme cluster in
+// that case, an empty range at the start of the cluster containing "end" is
+// returned.
+//
+// Offsets outside the string are clamped to [0, len(s)]. If "end" is smaller
+// than "start", it is set to "start".
+func SnapGraphemeBoundaries(s string, start, end int, outward bool) (newStart, newEnd int) {
+	// Clamp.
+	if start < 0 {
+		start = 0
+	} else if start > len(s) {
+		start = len(s)
+	}
+	if end < start {
+		end = start
+	} else if end > len(s) {
+		end = len(s)
+	}
+
+	if outward {
+		if start < len(s) {
+			start = graphemeClusterStart(nil, s, start)
+		}
+		if !IsGraphemeBoundary(s, end) {
+			end = graphemeClusterEnd(s, end)
+		}
+		return start, end
+	}
+
+	if !IsGraphemeBoundary(s, start) {
+		start = graphemeClusterEnd(s, start)
+	}
+	if end < len(s) && !IsGraphemeBoundary(s, end) {
+		end = graphemeClusterStart(nil, s, end)
+	}
+	if end < start {
+		return end, end
+	}
+	return start, end
+}
+
+// graphemeClusterStart returns the byte offset at which the grapheme cluster
+// containing the byte at the given offset of the byte slice or the string
+// (whichever is not nil) starts. The offset must be in the range [0, len(b))
+// or [0, len(str)), respectively.
+func graphemeClusterStart(b []byte, str string, offset int) int {
+	start := runeStart(b, str, offset)
+	var (
+		r      rune
+		length int
+	)
+	if b != nil {
+		r, length = utf8.DecodeRune(b[start:])
+	} else {
+		r, length = utf8.DecodeRuneInString(str[start:])
+	}
+	r = graphemeRune(r, length)
+	for start > 0 {
+		var (
+			boundary bool
+			prev     rune
+		)
+		if b != nil {
+			boundary, prev, length = graphemeBoundaryBefore(r, b[:start], "")
+		} else {
+			boundary, prev, length = graphemeBoundaryBefore(r, nil, str[:start])
+		}
+		if boundary {
+			break
+		}
+		start -= length
+		r = prev
+	}
+	return start
+}
+
+// graphemeClusterEnd returns the byte offset at which the grapheme cluster
+// containing the byte at the given offset ends. The offset must be in the
+// range [0, len(str)).
+func graphemeClusterEnd(str string, offset int) int {
+	start := graphemeClusterStart(nil, str, offset)
+	cluster, _, _, _ := FirstGraphemeClusterInString(str[start:], GraphemeState{})
+	return start + len(cluster)
+}
+
+// runeStart returns the byte offset of the first byte of the code point
+// containing the byte at the given offset of the byte slice or the string
+// (whichever is not nil), in the same way that ranging over the string would
+// decode it. Invalid bytes are code points of their own. The offset must be in
+// the range [0, len(b)) or [0, len(str)), respectively.
+func runeStart(b []byte, str string, offset int) int {
+	for start := offset; start >= 0 && start > offset-utf8.UTFMax; start-- {
+		var (
+			c      byte
+			length int
+		)
+		if b != nil {
+			c = b[start]
+		} else {
+			c = str[start]
+		}
+		if !utf8.RuneStart(c) {
+			continue
+		}
+		if b != nil {
+			_, length = utf8.DecodeRune(b[start:])
+		} else {
+			_, length = utf8.DecodeRuneInString(str[start:])
+		}
+		if start+length > offset {
+			return start
 		}
+		break
 	}
+	return offset
 }
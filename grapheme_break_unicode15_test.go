@@ -1,9 +1,11 @@
 // Code generated via go generate from gen_breaktest.go. DO NOT EDIT.
 
+//go:build !unicode14
+
 package uniseg
 
 // unicodeTestCases are Grapheme testcases taken from
-// https://www.unicode.org/Public/14.0.0/ucd/auxiliary/GraphemeBreakTest.txt,
+// https://www.unicode.org/Public/15.0.0/ucd/auxiliary/GraphemeBreakTest.txt,
 // see https://www.unicode.org/license.html for the Unicode license agreement.
 var unicodeTestCases = []testCase{
 	{original: "\u0020\u0020", expected: [][]rune{{0x0020}, {0x0020}}},                                                                                 // ÷ [0.2] SPACE (Other) ÷ [999.0] SPACE (Other) ÷ [0.3]
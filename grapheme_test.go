@@ -1,13 +1,20 @@
 package uniseg
 
 import (
+	"bufio"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"unicode/utf8"
 )
 
 const benchmarkStr = "This is 🏳️‍🌈, a test string ツ for grapheme cluster testing. 🏋🏽‍♀️🙂🙂"
 
 // Variables to avoid compiler optimizations.
 var resultRunes []rune
+var resultWidth int
+var resultStr string
 
 type testCase = struct {
 	original string
@@ -21,6 +28,7 @@ var testCases = []testCase{
 	{original: "basic", expected: [][]rune{{0x62}, {0x61}, {0x73}, {0x69}, {0x63}}},
 	{original: "möp", expected: [][]rune{{0x6d}, {0x6f, 0x308}, {0x70}}},
 	{original: "\r\n", expected: [][]rune{{0xd, 0xa}}},
+	{original: "\r\n\u0308", expected: [][]rune{{0xd, 0xa}, {0x308}}},
 	{original: "\n\n", expected: [][]rune{{0xa}, {0xa}}},
 	{original: "\t*", expected: [][]rune{{0x9}, {0x2a}}},
 	{original: "뢴", expected: [][]rune{{0x1105, 0x116c, 0x11ab}}},
@@ -141,6 +149,156 @@ func TestGraphemesPositions(t *testing.T) {
 	}
 }
 
+// Test that invalid UTF-8 bytes are returned unaltered, each as a grapheme
+// cluster of its own.
+func TestGraphemesInvalid(t *testing.T) {
+	for testNum, testCase := range []struct {
+		original string
+		expected []string
+		invalid  []int // The byte positions of the invalid clusters.
+	}{
+		{original: "\xff", expected: []string{"\xff"}, invalid: []int{0}},
+		{original: "a\xffb", expected: []string{"a", "\xff", "b"}, invalid: []int{1}},
+		{original: "\xff\u0308", expected: []string{"\xff", "\u0308"}, invalid: []int{0}},
+		{original: "\u0600\xff", expected: []string{"\u0600", "\xff"}, invalid: []int{2}},
+		{original: "\u200d\xf0\x9f\x98", expected: []string{"\u200d", "\xf0", "\x9f", "\x98"}, invalid: []int{3, 4, 5}},
+		{original: "\xed\xa0\x80", expected: []string{"\xed", "\xa0", "\x80"}, invalid: []int{0, 1, 2}}, // Surrogate.
+		{original: "x\ufffd\u0308", expected: []string{"x", "\ufffd\u0308"}},                            // A valid U+FFFD.
+		{original: "🇩\xff🇪", expected: []string{"🇩", "\xff", "🇪"}, invalid: []int{4}},
+	} {
+		var (
+			clusters []string
+			invalid  []int
+		)
+		gr := NewGraphemes(testCase.original)
+		for gr.Next() {
+			from, to := gr.Positions()
+			if str := gr.Str(); str != testCase.original[from:to] {
+				t.Errorf(`Test case %d %q failed: Str() returned %q, expected %q`,
+					testNum,
+					testCase.original,
+					str,
+					testCase.original[from:to])
+			}
+			if b := gr.Bytes(); string(b) != testCase.original[from:to] {
+				t.Errorf(`Test case %d %q failed: Bytes() returned %q, expected %q`,
+					testNum,
+					testCase.original,
+					b,
+					testCase.original[from:to])
+			}
+			if gr.Invalid() {
+				invalid = append(invalid, from)
+				if runes := gr.Runes(); len(runes) != 1 || runes[0] != utf8.RuneError {
+					t.Errorf(`Test case %d %q failed: Runes() returned %x for an invalid byte`,
+						testNum,
+						testCase.original,
+						runes)
+				}
+			}
+			clusters = append(clusters, gr.Str())
+		}
+		if !reflect.DeepEqual(clusters, testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Clusters are %q, expected %q`,
+				testNum,
+				testCase.original,
+				clusters,
+				testCase.expected)
+		}
+		if !reflect.DeepEqual(invalid, testCase.invalid) {
+			t.Errorf(`Test case %d %q failed: Invalid clusters at %d, expected %d`,
+				testNum,
+				testCase.original,
+				invalid,
+				testCase.invalid)
+		}
+	}
+}
+
+// Test reusing a zero-value Graphemes for strings and byte slices with Init()
+// and InitBytes(). The results must be the same as those of a new iterator.
+func TestGraphemesInit(t *testing.T) {
+	type cluster struct {
+		str      string
+		from, to int
+		width    int
+		runes    string
+	}
+	var gr Graphemes
+	allCases := append(testCases, unicodeTestCases...)
+	allCases = append(allCases, testCase{original: "a\xff\u0308b"})
+	for testNum, testCase := range allCases {
+		var expected []cluster
+		for ref := NewGraphemes(testCase.original); ref.Next(); {
+			from, to := ref.Positions()
+			expected = append(expected, cluster{ref.Str(), from, to, ref.Width(), string(ref.Runes())})
+		}
+		for _, useBytes := range []bool{false, true} {
+			if useBytes {
+				gr.InitBytes([]byte(testCase.original))
+			} else {
+				gr.Init(testCase.original)
+			}
+
+			// Forward.
+			var clusters []cluster
+			for gr.Next() {
+				if string(gr.Bytes()) != gr.Str() {
+					t.Errorf(`Test case %d %q (bytes: %t) failed: Bytes() returned %q, Str() returned %q`,
+						testNum,
+						testCase.original,
+						useBytes,
+						gr.Bytes(),
+						gr.Str())
+				}
+				from, to := gr.Positions()
+				clusters = append(clusters, cluster{gr.Str(), from, to, gr.Width(), string(gr.Runes())})
+			}
+			if !reflect.DeepEqual(clusters, expected) {
+				t.Errorf(`Test case %d %q (bytes: %t) failed: Clusters are %v, expected %v`,
+					testNum,
+					testCase.original,
+					useBytes,
+					clusters,
+					expected)
+				continue
+			}
+
+			// Backward.
+			for index := len(expected) - 1; index >= 0; index-- {
+				if !gr.Prev() || gr.Str() != expected[index].str {
+					t.Errorf(`Test case %d %q (bytes: %t) failed: Prev() returned %q, expected %q`,
+						testNum,
+						testCase.original,
+						useBytes,
+						gr.Str(),
+						expected[index].str)
+					break
+				}
+			}
+			if gr.Prev() {
+				t.Errorf(`Test case %d %q (bytes: %t) failed: Prev() returned true at the start`,
+					testNum,
+					testCase.original,
+					useBytes)
+			}
+
+			// Random access.
+			for _, c := range expected {
+				if !gr.Seek(c.to-1) || gr.Str() != c.str {
+					t.Errorf(`Test case %d %q (bytes: %t) failed: Seek(%d) returned %q, expected %q`,
+						testNum,
+						testCase.original,
+						useBytes,
+						c.to-1,
+						gr.Str(),
+						c.str)
+				}
+			}
+		}
+	}
+}
+
 // Test the Reset() function.
 func TestGraphemesReset(t *testing.T) {
 	gr := NewGraphemes("möp")
@@ -205,6 +363,39 @@ func TestGraphemesCount(t *testing.T) {
 	}
 }
 
+// Test that the Graphemes class and GraphemeClusterCount() don't allocate
+// memory for the code points of the string.
+func TestGraphemesAllocations(t *testing.T) {
+	if allocs := testing.AllocsPerRun(10, func() {
+		g := NewGraphemes(benchmarkStr)
+		for g.Next() {
+			resultStr = g.Str()
+			resultWidth = g.Width()
+		}
+	}); allocs > 1 {
+		t.Errorf(`Iterating with the Graphemes class allocated %.0f times, expected at most 1`, allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() {
+		resultWidth = GraphemeClusterCount(benchmarkStr)
+	}); allocs > 0 {
+		t.Errorf(`GraphemeClusterCount() allocated %.0f times, expected 0`, allocs)
+	}
+	var g Graphemes
+	b := []byte(benchmarkStr)
+	if allocs := testing.AllocsPerRun(10, func() {
+		g.Init(benchmarkStr)
+		for g.Next() {
+			resultStr = g.Str()
+		}
+		g.InitBytes(b)
+		for g.Next() {
+			resultWidth = len(g.Bytes()) + g.Width()
+		}
+	}); allocs > 0 {
+		t.Errorf(`Reusing a Graphemes value allocated %.0f times, expected 0`, allocs)
+	}
+}
+
 // Run all lists of test cases using the Graphemes function for byte slices.
 func TestGraphemesFunctionBytes(t *testing.T) {
 	allCases := append(testCases, unicodeTestCases...)
@@ -216,14 +407,14 @@ func TestGraphemesFunctionBytes(t *testing.T) {
 		decomposed(testCase.original),
 		[]rune(testCase.original))*/
 		b := []byte(testCase.original)
-		state := -1
+		var state GraphemeState
 		var (
 			index int
 			c     []byte
 		)
 	GraphemeLoop:
 		for len(b) > 0 {
-			c, b, state = firstGraphemeCluster(b, state)
+			c, b, _, state = FirstGraphemeCluster(b, state)
 
 			if index >= len(testCase.expected) {
 				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
@@ -280,14 +471,14 @@ func TestGraphemesFunctionString(t *testing.T) {
 		decomposed(testCase.original),
 		[]rune(testCase.original))*/
 		str := testCase.original
-		state := -1
+		var state GraphemeState
 		var (
 			index int
 			c     string
 		)
 	GraphemeLoop:
 		for len(str) > 0 {
-			c, str, state = firstGraphemeClusterInString(str, state)
+			c, str, _, state = FirstGraphemeClusterInString(str, state)
 
 			if index >= len(testCase.expected) {
 				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
@@ -333,6 +524,559 @@ func TestGraphemesFunctionString(t *testing.T) {
 	}
 }
 
+// Test the TraceGraphemes() function. The expected traces are taken from the
+// comments of GraphemeBreakTest.txt.
+func TestGraphemesTrace(t *testing.T) {
+	for index, testCase := range []struct {
+		original, expected string
+	}{
+		{"", ""},
+		{"x", "÷ [0.2] 0078 ÷ [0.3]"},
+		{"\r\n\u0308", "÷ [0.2] 000D × [3.0] 000A ÷ [4.0] 0308 ÷ [0.3]"},
+		{"\u0020\u0308\u0020", "÷ [0.2] 0020 × [9.0] 0308 ÷ [999.0] 0020 ÷ [0.3]"},
+		{"\u0600\u0020", "÷ [0.2] 0600 × [9.2] 0020 ÷ [0.3]"},
+		{"\u0600\u000a", "÷ [0.2] 0600 ÷ [5.0] 000A ÷ [0.3]"},
+		{"\u0020\u0903", "÷ [0.2] 0020 × [9.1] 0903 ÷ [0.3]"},
+		{"\u1100\uac01\u11a8", "÷ [0.2] 1100 × [6.0] AC01 × [8.0] 11A8 ÷ [0.3]"},
+		{"\u1160\u11a8", "÷ [0.2] 1160 × [7.0] 11A8 ÷ [0.3]"},
+		{"\U0001f1e6\U0001f1e7\U0001f1e8", "÷ [0.2] 1F1E6 × [12.0] 1F1E7 ÷ [999.0] 1F1E8 ÷ [0.3]"},
+		{"a\U0001f1e6\U0001f1e7\U0001f1e8b", "÷ [0.2] 0061 ÷ [999.0] 1F1E6 × [13.0] 1F1E7 ÷ [999.0] 1F1E8 ÷ [999.0] 0062 ÷ [0.3]"},
+		{"\u231a\u0308\u200d\u2701", "÷ [0.2] 231A × [9.0] 0308 × [9.0] 200D × [11.0] 2701 ÷ [0.3]"},
+		{"\u0061\u200d\u2701", "÷ [0.2] 0061 × [9.0] 200D ÷ [999.0] 2701 ÷ [0.3]"},
+		{"\xff\u0308", "÷ [0.2] FFFD ÷ [4.0] 0308 ÷ [0.3]"},
+		{"a\xe2\x82", "÷ [0.2] 0061 ÷ [5.0] FFFD ÷ [4.0] FFFD ÷ [0.3]"},
+	} {
+		if trace := TraceGraphemes(testCase.original); trace != testCase.expected {
+			t.Errorf(`Test case %d %q failed: Expected %q, got %q`, index, testCase.original, testCase.expected, trace)
+		}
+	}
+}
+
+// Test the LastGraphemeCluster function for byte slices.
+func TestGraphemesLastFunctionBytes(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		b := []byte(testCase.original)
+		index := len(testCase.expected) - 1
+		var c []byte
+		for len(b) > 0 {
+			c, b = LastGraphemeCluster(b)
+			if index < 0 {
+				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			cluster := []rune(string(c))
+			if string(cluster) != string(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Grapheme cluster at index %d is %x, expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					cluster,
+					testCase.expected[index])
+				break
+			}
+			index--
+		}
+		if len(b) == 0 && index >= 0 {
+			t.Errorf(`Test case %d "%s" failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index-1,
+				len(testCase.expected))
+		}
+	}
+	c, rest := LastGraphemeCluster([]byte{})
+	if len(c) > 0 {
+		t.Errorf(`Expected cluster to be empty byte slice, got %q`, c)
+	}
+	if len(rest) > 0 {
+		t.Errorf(`Expected rest to be empty byte slice, got %q`, rest)
+	}
+}
+
+// Test the LastGraphemeClusterInString function.
+func TestGraphemesLastFunctionString(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		str := testCase.original
+		index := len(testCase.expected) - 1
+		var c string
+		for len(str) > 0 {
+			c, str = LastGraphemeClusterInString(str)
+			if index < 0 {
+				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			if c != string(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Grapheme cluster at index %d is %x, expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(c),
+					testCase.expected[index])
+				break
+			}
+			index--
+		}
+		if len(str) == 0 && index >= 0 {
+			t.Errorf(`Test case %d "%s" failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index-1,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test iterating backwards with Prev() after the iterator has reached the end.
+func TestGraphemesPrev(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		gr := NewGraphemes(testCase.original)
+		for gr.Next() {
+		}
+		index := len(testCase.expected) - 1
+		for gr.Prev() {
+			if index < 0 {
+				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			if string(gr.Runes()) != string(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Grapheme cluster at index %d is %x, expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					gr.Runes(),
+					testCase.expected[index])
+				break
+			}
+			index--
+		}
+		if index >= 0 {
+			t.Errorf(`Test case %d "%s" failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index-1,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test mixing calls to Next() and Prev().
+func TestGraphemesNextPrev(t *testing.T) {
+	gr := NewGraphemes("a🇩🇪🇫🇷e\u0301👩\u200d❤\ufe0f\u200d👨!")
+	expect := func(step, expected string) {
+		if gr.Str() != expected {
+			t.Errorf(`Step %s: Expected cluster %q, got %q`, step, expected, gr.Str())
+		}
+	}
+	for i := 0; i < 4; i++ {
+		gr.Next()
+	}
+	expect("next 4", "e\u0301")
+	gr.Prev()
+	expect("prev 1", "🇫🇷")
+	gr.Prev()
+	expect("prev 2", "🇩🇪")
+	gr.Next()
+	expect("next 5", "🇫🇷")
+	gr.Next()
+	gr.Next()
+	expect("next 7", "👩\u200d❤\ufe0f\u200d👨")
+	gr.Next()
+	if gr.Next() {
+		t.Error("Expected Next() to return false at the end")
+	}
+	gr.Prev()
+	expect("prev end", "!")
+	gr.Prev()
+	expect("prev 3", "👩\u200d❤\ufe0f\u200d👨")
+	for gr.Prev() {
+	}
+	if from, to := gr.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected positions 0, 0 after Prev() returned false, got %d, %d`, from, to)
+	}
+	gr.Next()
+	expect("restart", "a")
+}
+
+// Test the IsGraphemeBoundary function at every byte offset.
+func TestGraphemesIsBoundary(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		boundaries := map[int]bool{0: true}
+		var offset int
+		for _, cluster := range testCase.expected {
+			offset += len(string(cluster))
+			boundaries[offset] = true
+		}
+		for offset := 0; offset <= len(testCase.original); offset++ {
+			if IsGraphemeBoundary(testCase.original, offset) != boundaries[offset] {
+				t.Errorf(`Test case %d "%s" failed: Expected boundary at offset %d to be %t, code points %x`,
+					testNum,
+					testCase.original,
+					offset,
+					boundaries[offset],
+					[]rune(testCase.original))
+				break
+			}
+		}
+	}
+}
+
+// Test seeking to every byte offset.
+func TestGraphemesSeek(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		gr := NewGraphemes(testCase.original)
+		var start int
+	ClusterLoop:
+		for index, cluster := range testCase.expected {
+			end := start + len(string(cluster))
+			for offset := start; offset < end; offset++ {
+				if !gr.Seek(offset) {
+					t.Errorf(`Test case %d "%s" failed: Seek(%d) returned false`, testNum, testCase.original, offset)
+					break ClusterLoop
+				}
+				if from, to := gr.Positions(); from != start || to != end || string(gr.Runes()) != string(cluster) {
+					t.Errorf(`Test case %d "%s" failed: Seek(%d) returned cluster %x at %d-%d, expected %x at %d-%d`,
+						testNum,
+						testCase.original,
+						offset,
+						gr.Runes(),
+						from,
+						to,
+						cluster,
+						start,
+						end)
+					break ClusterLoop
+				}
+				if index+1 < len(testCase.expected) {
+					if !gr.Next() || string(gr.Runes()) != string(testCase.expected[index+1]) {
+						t.Errorf(`Test case %d "%s" failed: Next() after Seek(%d) returned %x, expected %x`,
+							testNum,
+							testCase.original,
+							offset,
+							gr.Runes(),
+							testCase.expected[index+1])
+						break ClusterLoop
+					}
+				} else if gr.Next() {
+					t.Errorf(`Test case %d "%s" failed: Next() after Seek(%d) returned true at the end`, testNum, testCase.original, offset)
+					break ClusterLoop
+				}
+			}
+			start = end
+		}
+		if gr.Seek(len(testCase.original)) {
+			t.Errorf(`Test case %d "%s" failed: Seek() to the end returned true`, testNum, testCase.original)
+		}
+		if !gr.Prev() && len(testCase.expected) > 0 {
+			t.Errorf(`Test case %d "%s" failed: Prev() after Seek() to the end returned false`, testNum, testCase.original)
+		}
+	}
+}
+
+// Test snapping byte ranges to grapheme cluster boundaries.
+func TestGraphemesSnapBoundaries(t *testing.T) {
+	const str = "ae\u0301🇩🇪🇫🇷x" // Byte offsets: a=0, e=1, \u0301=2, 🇩🇪=4, 🇫🇷=12, x=20.
+	for index, testCase := range []struct {
+		start, end   int
+		outward      bool
+		expFrom, exp int
+	}{
+		{0, 1, true, 0, 1},
+		{0, 2, true, 0, 4},
+		{2, 3, true, 1, 4},
+		{8, 9, true, 4, 12},
+		{8, 14, true, 4, 20},
+		{-5, 100, true, 0, 21},
+		{0, 2, false, 0, 1},
+		{2, 20, false, 4, 20},
+		{5, 19, false, 12, 12},
+		{5, 7, false, 4, 4},
+		{3, 3, false, 1, 1},
+		{10, 5, false, 4, 4},
+		{-5, 100, false, 0, 21},
+	} {
+		from, to := SnapGraphemeBoundaries(str, testCase.start, testCase.end, testCase.outward)
+		if from != testCase.expFrom || to != testCase.exp {
+			t.Errorf(`Test case %d failed: Snapping [%d, %d) (outward: %t) resulted in [%d, %d), expected [%d, %d)`,
+				index,
+				testCase.start,
+				testCase.end,
+				testCase.outward,
+				from,
+				to,
+				testCase.expFrom,
+				testCase.exp)
+		}
+	}
+}
+
+// Test the grapheme property lookup for a few selected code points.
+func TestGraphemeProperty(t *testing.T) {
+	for index, testCase := range []struct {
+		r        rune
+		expected int
+	}{
+		{-1, prAny},
+		{0x0000, prControl},
+		{0x000a, prLF},
+		{0x000d, prCR},
+		{0x0041, prAny},
+		{0x00a9, prExtendedPictographic},
+		{0x00ad, prControl},
+		{0x00ff, prAny},
+		{0x0300, prExtend},
+		{0x0600, prPrepend},
+		{0x0903, prSpacingMark},
+		{0x1100, prL},
+		{0x1160, prV},
+		{0x11a8, prT},
+		{0xac00, prLV},
+		{0xac01, prLVT},
+		{0xd7a3, prLVT},
+		{0x200d, prZWJ},
+		{0x1f1e6, prRegionalIndicator},
+		{0x1f600, prExtendedPictographic},
+		{0x1fffd, prExtendedPictographic},
+		{0xe0001, prControl},
+		{0xe0100, prExtend},
+		{0x10ffff, prAny},
+		{0x110000, prAny},
+	} {
+		if property := graphemeProperty(testCase.r); property != testCase.expected {
+			t.Errorf("Test case %d failed: Property of %x is %d, expected %d", index, testCase.r, property, testCase.expected)
+		}
+	}
+}
+
+// Test the exported GraphemeProperty() function and the names of the
+// properties.
+func TestGraphemeBreakProperty(t *testing.T) {
+	for index, testCase := range []struct {
+		r        rune
+		expected GraphemeBreakProperty
+		name     string
+	}{
+		{0x0041, GraphemeBreakOther, "Other"},
+		{0x0600, GraphemeBreakPrepend, "Prepend"},
+		{0x000d, GraphemeBreakCR, "CR"},
+		{0x000a, GraphemeBreakLF, "LF"},
+		{0x0000, GraphemeBreakControl, "Control"},
+		{0x0300, GraphemeBreakExtend, "Extend"},
+		{0x1f1e6, GraphemeBreakRegionalIndicator, "Regional_Indicator"},
+		{0x0903, GraphemeBreakSpacingMark, "SpacingMark"},
+		{0x1100, GraphemeBreakL, "L"},
+		{0x1160, GraphemeBreakV, "V"},
+		{0x11a8, GraphemeBreakT, "T"},
+		{0xac00, GraphemeBreakLV, "LV"},
+		{0xac01, GraphemeBreakLVT, "LVT"},
+		{0x200d, GraphemeBreakZWJ, "ZWJ"},
+		{0x1f600, GraphemeBreakExtendedPictographic, "Extended_Pictographic"},
+		{-1, GraphemeBreakOther, "Other"},
+		{0x110000, GraphemeBreakOther, "Other"},
+	} {
+		property := GraphemeProperty(testCase.r)
+		if property != testCase.expected {
+			t.Errorf("Test case %d failed: Property of %x is %d, expected %d", index, testCase.r, property, testCase.expected)
+		}
+		if name := property.String(); name != testCase.name {
+			t.Errorf("Test case %d failed: Property of %x is %q, expected %q", index, testCase.r, name, testCase.name)
+		}
+	}
+	if name := GraphemeBreakProperty(99).String(); name != "GraphemeBreakProperty(99)" {
+		t.Errorf(`Expected "GraphemeBreakProperty(99)", got %q`, name)
+	}
+}
+
+// Test GB9c on the compiled transition table. The tables of Unicode versions
+// before 15.1.0 don't contain any Indic_Conjunct_Break values so we use crafted
+// values here.
+func TestGraphemesIndicConjunct(t *testing.T) {
+	const (
+		consonant = prAny | incbConsonant
+		linker    = prExtend | incbLinker
+		extend    = prExtend | incbExtend
+		zwj       = prZWJ | incbExtend
+	)
+	for index, testCase := range []struct {
+		values   []int
+		expected []bool // Boundaries before the second, third, ... value.
+	}{
+		{[]int{consonant, linker, consonant}, []bool{false, false}},
+		{[]int{consonant, extend, linker, zwj, extend, consonant}, []bool{false, false, false, false, false}},
+		{[]int{consonant, linker, linker, consonant, linker, consonant}, []bool{false, false, false, false, false}},
+		{[]int{consonant, extend, consonant}, []bool{false, true}},
+		{[]int{consonant, consonant}, []bool{true}},
+		{[]int{linker, consonant}, []bool{true}},
+		{[]int{prAny, linker, consonant}, []bool{false, true}},
+		{[]int{consonant, linker, prExtend, consonant}, []bool{false, false, true}},
+		{[]int{consonant, linker, prAny}, []bool{false, true}},
+		{[]int{prPrepend, consonant, linker, consonant}, []bool{false, false, false}},
+	} {
+		state := int(grTable[grAny][testCase.values[0]] >> 1)
+		for i, value := range testCase.values[1:] {
+			transition := grTable[state][value]
+			state = int(transition >> 1)
+			if boundary := transition&1 == grBoundary; boundary != testCase.expected[i] {
+				t.Errorf("Test case %d failed: Boundary before value %d is %t, expected %t", index, i+1, boundary, testCase.expected[i])
+			}
+		}
+	}
+}
+
+// setGraphemeIncb sets the Indic_Conjunct_Break value of the given code point
+// in the grapheme lookup tables and returns a function which restores the
+// previous value.
+func setGraphemeIncb(r rune, incb int) (restore func()) {
+	const mask = 1<<trieBlockBits - 1
+	block := int(graphemeStage1[r>>(2*trieBlockBits)])<<trieBlockBits | int(r>>trieBlockBits)&mask
+	entry := &graphemeStage3[int(graphemeStage2[block])<<trieBlockBits|int(r)&mask]
+	previous := *entry
+	*entry = *entry&^incbMask | uint8(incb)
+	return func() { *entry = previous }
+}
+
+// Test GB9c through all functions which find grapheme cluster boundaries,
+// going forward, backward, and from arbitrary offsets. The code points are
+// given their Unicode 15.1.0 Indic_Conjunct_Break values for the duration of
+// the test.
+func TestGraphemesIndicConjunctText(t *testing.T) {
+	for _, value := range []struct {
+		r    rune
+		incb int
+	}{
+		{0x0915, incbConsonant}, // DEVANAGARI LETTER KA
+		{0x0924, incbConsonant}, // DEVANAGARI LETTER TA
+		{0x0937, incbConsonant}, // DEVANAGARI LETTER SSA
+		{0x093c, incbExtend},    // DEVANAGARI SIGN NUKTA
+		{0x094d, incbLinker},    // DEVANAGARI SIGN VIRAMA
+		{0x200d, incbExtend},    // ZERO WIDTH JOINER
+	} {
+		defer setGraphemeIncb(value.r, value.incb)()
+	}
+
+	for testNum, testCase := range []testCase{
+		{original: "\u0915\u094d\u0937", expected: [][]rune{{0x915, 0x94d, 0x937}}},
+		{original: "\u0915\u093c\u094d\u200d\u0924", expected: [][]rune{{0x915, 0x93c, 0x94d, 0x200d, 0x924}}},
+		{original: "\u0915\u094d\u094d\u0924\u094d\u0915", expected: [][]rune{{0x915, 0x94d, 0x94d, 0x924, 0x94d, 0x915}}},
+		{original: "\u0915\u093c\u0924", expected: [][]rune{{0x915, 0x93c}, {0x924}}},
+		{original: "\u0915\u0924", expected: [][]rune{{0x915}, {0x924}}},
+		{original: "\u094d\u0924", expected: [][]rune{{0x94d}, {0x924}}},
+		{original: "a\u094d\u0924", expected: [][]rune{{0x61, 0x94d}, {0x924}}},
+		{original: "\u0915\u094d\u0903\u0924", expected: [][]rune{{0x915, 0x94d, 0x903}, {0x924}}},
+		{original: "\u0915\u094da", expected: [][]rune{{0x915, 0x94d}, {0x61}}},
+		{original: "\u0600\u0915\u094d\u0924", expected: [][]rune{{0x600, 0x915, 0x94d, 0x924}}},
+		{original: "x\u0915\u094d\u0924 \u0915\u094d\u0937\u094d\u0924", expected: [][]rune{{0x78}, {0x915, 0x94d, 0x924}, {0x20}, {0x915, 0x94d, 0x937, 0x94d, 0x924}}},
+	} {
+		var (
+			clusters   []string
+			boundaries = map[int]bool{0: true}
+			offset     int
+		)
+		for _, cluster := range testCase.expected {
+			clusters = append(clusters, string(cluster))
+			offset += len(string(cluster))
+			boundaries[offset] = true
+		}
+
+		// Forward.
+		var forward []string
+		g := NewGraphemes(testCase.original)
+		for g.Next() {
+			forward = append(forward, g.Str())
+		}
+		if !reflect.DeepEqual(forward, clusters) {
+			t.Errorf("Test case %d %q failed: Graphemes returned %q, expected %q", testNum, testCase.original, forward, clusters)
+		}
+		forward = nil
+		var state GraphemeState
+		for rest := []byte(testCase.original); len(rest) > 0; {
+			var cluster []byte
+			cluster, rest, _, state = FirstGraphemeCluster(rest, state)
+			forward = append(forward, string(cluster))
+		}
+		if !reflect.DeepEqual(forward, clusters) {
+			t.Errorf("Test case %d %q failed: FirstGraphemeCluster() returned %q, expected %q", testNum, testCase.original, forward, clusters)
+		}
+
+		// Backward.
+		var backward []string
+		g.Seek(len(testCase.original))
+		for g.Prev() {
+			backward = append([]string{g.Str()}, backward...)
+		}
+		if !reflect.DeepEqual(backward, clusters) {
+			t.Errorf("Test case %d %q failed: Graphemes.Prev() returned %q, expected %q", testNum, testCase.original, backward, clusters)
+		}
+		backward = nil
+		for rest := []byte(testCase.original); len(rest) > 0; {
+			var cluster []byte
+			cluster, rest = LastGraphemeCluster(rest)
+			backward = append([]string{string(cluster)}, backward...)
+		}
+		if !reflect.DeepEqual(backward, clusters) {
+			t.Errorf("Test case %d %q failed: LastGraphemeCluster() returned %q, expected %q", testNum, testCase.original, backward, clusters)
+		}
+		backward = nil
+		for rest := testCase.original; len(rest) > 0; {
+			var cluster string
+			cluster, rest = LastGraphemeClusterInString(rest)
+			backward = append([]string{cluster}, backward...)
+		}
+		if !reflect.DeepEqual(backward, clusters) {
+			t.Errorf("Test case %d %q failed: LastGraphemeClusterInString() returned %q, expected %q", testNum, testCase.original, backward, clusters)
+		}
+
+		// Arbitrary offsets.
+		var start int
+		for offset := 0; offset < len(testCase.original); offset++ {
+			if boundaries[offset] {
+				start = offset
+			}
+			if !g.Seek(offset) {
+				t.Errorf("Test case %d %q failed: Seek(%d) returned false", testNum, testCase.original, offset)
+			} else if from, _ := g.Positions(); from != start {
+				t.Errorf("Test case %d %q failed: Seek(%d) moved to %d, expected %d", testNum, testCase.original, offset, from, start)
+			}
+		}
+		for offset := 0; offset <= len(testCase.original); offset++ {
+			if boundary := IsGraphemeBoundary(testCase.original, offset); boundary != boundaries[offset] {
+				t.Errorf("Test case %d %q failed: IsGraphemeBoundary(%d) returned %t, expected %t", testNum, testCase.original, offset, boundary, boundaries[offset])
+			}
+		}
+	}
+}
+
+// Test the Reverse() function.
+func TestGraphemesReverse(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		var expected string
+		for _, cluster := range testCase.expected {
+			expected = string(cluster) + expected
+		}
+		if reversed := Reverse(testCase.original); reversed != expected {
+			t.Errorf(`Test case %d "%s" failed: Reverse() returned %x, expected %x`,
+				testNum,
+				testCase.original,
+				[]rune(reversed),
+				[]rune(expected))
+		}
+	}
+}
+
 // Benchmark the use of the Graphemes class.
 func BenchmarkGraphemesClass(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -343,14 +1087,24 @@ func BenchmarkGraphemesClass(b *testing.B) {
 	}
 }
 
+// Benchmark the GraphemeClusterCount function.
+func BenchmarkGraphemeClusterCount(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		resultWidth = GraphemeClusterCount(benchmarkStr)
+	}
+}
+
 // Benchmark the use of the Graphemes function for byte slices.
 func BenchmarkGraphemesFunctionBytes(b *testing.B) {
-	str := []byte(benchmarkStr)
+	original := []byte(benchmarkStr)
 	for i := 0; i < b.N; i++ {
-		var c []byte
-		state := -1
+		var (
+			c     []byte
+			state GraphemeState
+		)
+		str := original
 		for len(str) > 0 {
-			c, str, state = firstGraphemeCluster(str, state)
+			c, str, _, state = FirstGraphemeCluster(str, state)
 			resultRunes = []rune(string(c))
 		}
 	}
@@ -358,13 +1112,241 @@ func BenchmarkGraphemesFunctionBytes(b *testing.B) {
 
 // Benchmark the use of the Graphemes function for strings.
 func BenchmarkGraphemesFunctionString(b *testing.B) {
-	str := benchmarkStr
 	for i := 0; i < b.N; i++ {
-		var c string
-		state := -1
+		var (
+			c     string
+			state GraphemeState
+		)
+		str := benchmarkStr
 		for len(str) > 0 {
-			c, str, state = firstGraphemeClusterInString(str, state)
+			c, str, _, state = FirstGraphemeClusterInString(str, state)
 			resultRunes = []rune(string(c))
 		}
 	}
 }
+
+// Test the boundary flag returned by the FirstGraphemeCluster functions.
+func TestGraphemesFunctionBoundary(t *testing.T) {
+	for _, testCase := range []struct {
+		original string
+		cluster  string
+		boundary bool
+	}{
+		{original: "x", cluster: "x", boundary: false},
+		{original: "xy", cluster: "x", boundary: true},
+		{original: "x\u0308", cluster: "x\u0308", boundary: false},
+		{original: "\U0001f469\u200d", cluster: "\U0001f469\u200d", boundary: false},
+		{original: "\U0001f1e9", cluster: "\U0001f1e9", boundary: false},
+		{original: "\r", cluster: "\r", boundary: false},
+		{original: "\r\n", cluster: "\r\n", boundary: true},
+		{original: "\n", cluster: "\n", boundary: true},
+		{original: "\t", cluster: "\t", boundary: true},
+	} {
+		c, _, boundary, _ := FirstGraphemeCluster([]byte(testCase.original), GraphemeState{})
+		if string(c) != testCase.cluster || boundary != testCase.boundary {
+			t.Errorf(`Test case "%x" failed: Expected cluster %x with boundary %t, got %x with boundary %t`,
+				testCase.original,
+				testCase.cluster,
+				testCase.boundary,
+				c,
+				boundary)
+		}
+		str, _, boundary, _ := FirstGraphemeClusterInString(testCase.original, GraphemeState{})
+		if str != testCase.cluster || boundary != testCase.boundary {
+			t.Errorf(`Test case "%x" failed: Expected cluster %x with boundary %t, got %x with boundary %t (string version)`,
+				testCase.original,
+				testCase.cluster,
+				testCase.boundary,
+				str,
+				boundary)
+		}
+	}
+}
+
+// Run all lists of test cases using a bufio.Scanner with ScanGraphemes, reading
+// one byte at a time such that clusters are split across buffer edges.
+func TestGraphemesScanner(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(testCase.original)))
+		scanner.Split(ScanGraphemes)
+		var index int
+		for scanner.Scan() {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			if cluster := scanner.Text(); cluster != string(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Grapheme cluster at index %d is %x, expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(cluster),
+					testCase.expected[index])
+				break
+			}
+			index++
+		}
+		if err := scanner.Err(); err != nil {
+			t.Errorf(`Test case %d "%s" failed: %s`, testNum, testCase.original, err)
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test that ScanGraphemes requests more data when a cluster may continue.
+func TestGraphemesScanMoreData(t *testing.T) {
+	for _, testCase := range []struct {
+		data    string
+		atEOF   bool
+		advance int
+	}{
+		{data: "", atEOF: false, advance: 0},
+		{data: "", atEOF: true, advance: 0},
+		{data: "x", atEOF: false, advance: 0},
+		{data: "x", atEOF: true, advance: 1},
+		{data: "xy", atEOF: false, advance: 1},
+		{data: "\U0001f469\u200d", atEOF: false, advance: 0},
+		{data: "\U0001f469\u200d", atEOF: true, advance: 7},
+		{data: "x\xcc", atEOF: false, advance: 0},                // Incomplete combining mark.
+		{data: "x\xcc", atEOF: true, advance: 1},                 // Invalid UTF-8 at EOF.
+		{data: "\xe2\x80", atEOF: false, advance: 0},             // Incomplete first code point.
+		{data: "\r", atEOF: false, advance: 0},                   // Could be followed by \n.
+		{data: "\r\n", atEOF: false, advance: 2},                 // GB4.
+		{data: "\U0001f1e9\U0001f1ea", atEOF: false, advance: 0}, // Could be followed by Extend.
+		{data: "\U0001f1e9\U0001f1eax", atEOF: false, advance: 8},
+	} {
+		advance, token, err := ScanGraphemes([]byte(testCase.data), testCase.atEOF)
+		if err != nil || advance != testCase.advance || string(token) != testCase.data[:advance] || advance == 0 && token != nil {
+			t.Errorf(`Test case %q (atEOF=%t) failed: Expected advance %d, got %d, token %q, error %v`,
+				testCase.data,
+				testCase.atEOF,
+				testCase.advance,
+				advance,
+				token,
+				err)
+		}
+	}
+}
+
+// Benchmark the grapheme property lookup.
+func BenchmarkGraphemeProperty(b *testing.B) {
+	runes := []rune(benchmarkStr)
+	for i := 0; i < b.N; i++ {
+		for _, r := range runes {
+			resultWidth = graphemeProperty(r)
+		}
+	}
+}
+
+// Fuzz the grapheme cluster functions, checking that all entry points agree
+// with each other and that the clusters make up the original text.
+func FuzzGraphemes(f *testing.F) {
+	for _, testCase := range append(testCases, unicodeTestCases...) {
+		f.Add(testCase.original)
+	}
+	f.Add("\u200d")               // Trailing ZWJ.
+	f.Add("x\u200d")              // Same.
+	f.Add("\U0001f469\u200d")     // Same, after an emoji.
+	f.Add("\U0001f469\u200d\xf0") // Incomplete code point after ZWJ.
+	f.Add("\xff\u0308\xcc\x88")   // Invalid UTF-8 with combining marks.
+
+	f.Fuzz(func(t *testing.T, str string) {
+		// The Graphemes class.
+		var (
+			classClusters []string
+			lastTo        int
+		)
+		g := NewGraphemes(str)
+		for g.Next() {
+			from, to := g.Positions()
+			if from != lastTo || to <= from {
+				t.Fatalf("%q: Positions() returned %d, %d after %d", str, from, to, lastTo)
+			}
+			if g.Str() != str[from:to] || string(g.Bytes()) != str[from:to] {
+				t.Fatalf("%q: Str() returned %q and Bytes() returned %q for %q", str, g.Str(), g.Bytes(), str[from:to])
+			}
+			r, length := utf8.DecodeRuneInString(str[from:to])
+			if invalid := r == utf8.RuneError && length == 1; g.Invalid() != invalid || invalid && to-from != 1 {
+				t.Fatalf("%q: Invalid() returned %t for %q", str, g.Invalid(), str[from:to])
+			}
+			classClusters = append(classClusters, g.Str())
+			lastTo = to
+		}
+		if lastTo != len(str) {
+			t.Fatalf("%q: Clusters end at %d, expected %d", str, lastTo, len(str))
+		}
+
+		// The Graphemes class on a byte slice.
+		var bytesClusters []string
+		g.InitBytes([]byte(str))
+		for g.Next() {
+			bytesClusters = append(bytesClusters, string(g.Bytes()))
+		}
+
+		// The byte slice function.
+		var (
+			byteClusters []string
+			c            []byte
+			state        GraphemeState
+		)
+		b := []byte(str)
+		for len(b) > 0 {
+			c, b, _, state = FirstGraphemeCluster(b, state)
+			if len(c) == 0 {
+				t.Fatalf("%q: FirstGraphemeCluster() returned an empty cluster", str)
+			}
+			byteClusters = append(byteClusters, string(c))
+		}
+
+		// The string function.
+		var (
+			stringClusters []string
+			cluster        string
+		)
+		state = GraphemeState{}
+		for rest := str; len(rest) > 0; {
+			cluster, rest, _, state = FirstGraphemeClusterInString(rest, state)
+			if len(cluster) == 0 {
+				t.Fatalf("%q: FirstGraphemeClusterInString() returned an empty cluster", str)
+			}
+			stringClusters = append(stringClusters, cluster)
+		}
+
+		// The backward function.
+		var lastClusters []string
+		for rest := str; len(rest) > 0; {
+			cluster, rest = LastGraphemeClusterInString(rest)
+			if len(cluster) == 0 {
+				t.Fatalf("%q: LastGraphemeClusterInString() returned an empty cluster", str)
+			}
+			lastClusters = append([]string{cluster}, lastClusters...)
+		}
+
+		// Compare.
+		if strings.Join(classClusters, "") != str {
+			t.Fatalf("%q: Clusters %q don't concatenate to the original", str, classClusters)
+		}
+		if !reflect.DeepEqual(bytesClusters, classClusters) {
+			t.Fatalf("%q: Graphemes on a byte slice returned %q, on a string %q", str, bytesClusters, classClusters)
+		}
+		if !reflect.DeepEqual(byteClusters, classClusters) {
+			t.Fatalf("%q: FirstGraphemeCluster() returned %q, Graphemes returned %q", str, byteClusters, classClusters)
+		}
+		if !reflect.DeepEqual(stringClusters, classClusters) {
+			t.Fatalf("%q: FirstGraphemeClusterInString() returned %q, Graphemes returned %q", str, stringClusters, classClusters)
+		}
+		if !reflect.DeepEqual(lastClusters, classClusters) {
+			t.Fatalf("%q: LastGraphemeClusterInString() returned %q, Graphemes returned %q", str, lastClusters, classClusters)
+		}
+	})
+}
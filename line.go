@@ -0,0 +1,145 @@
+package uniseg
+
+import "unicode/utf8"
+
+// LineState holds the state of the line break parser between calls to
+// FirstLineSegment() or FirstLineSegmentInString(). Its zero value represents
+// an unknown state, which is what must be passed when parsing starts. Its
+// fields are internal and may change in future versions.
+type LineState struct {
+	// The state of the line break parser after the first code point of the
+	// next segment has been processed.
+	state int
+
+	// The state of the grapheme cluster parser at the same position. Line
+	// breaks are only allowed at grapheme cluster boundaries.
+	graphemeState int
+
+	// Whether or not the states above contain valid parser states.
+	valid bool
+}
+
+// FirstLineSegment returns the prefix of the given byte slice after which a
+// decision to break the string over to the next line can or must be made,
+// according to the rules of Unicode Standard Annex #14, Unicode Line Breaking
+// Algorithm. This is used to implement line breaking and word wrapping.
+//
+// Line breaking, also known as word wrapping, is the process of breaking a
+// section of text into lines such that it will fit in the available width of a
+// page, window or other display area. Segments returned by this function never
+// split grapheme clusters.
+//
+// The returned "segment" may not be broken into smaller parts, unless no other
+// breaking opportunities present themselves, in which case you may break by
+// grapheme clusters (using the FirstGraphemeCluster() function to determine
+// the grapheme clusters).
+//
+// The "mustBreak" flag indicates whether you MUST break the line after the
+// given segment (true), for example after newline characters (BK, CR, LF, NL),
+// or merely CAN break the line (false). The end of the text is always a
+// mandatory break (LB3).
+//
+// This function can be called continuously to extract all non-breaking
+// segments from a byte slice, as follows:
+//
+//   var state uniseg.LineState
+//   for len(b) > 0 {
+//       segment, b, mustBreak, state = uniseg.FirstLineSegment(b, state)
+//       // Do something with segment and mustBreak.
+//   }
+//
+// If you don't know the current state, for example when calling the function
+// for the first time, you must pass the zero value of LineState. For
+// consecutive calls, you should pass the state returned by the previous call.
+//
+// The "rest" slice is the subslice of the original byte slice "b" starting
+// after the last byte of the identified line segment. If the length of the
+// "rest" slice is 0, the entire byte slice "b" has been processed.
+//
+// For an empty byte slice "b", the function returns nil values.
+//
+// Note that in accordance with UAX #14 LB1, for Southeast Asian scripts
+// (complex context, "SA"), this function does not implement dictionary or
+// language-specific lookups. It treats these characters as "AL" (or "CM" if
+// they are combining marks).
+func FirstLineSegment(b []byte, state LineState) (segment, rest []byte, mustBreak bool, newState LineState) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRune(b)
+	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
+		return b, nil, true, LineState{} // LB3.
+	}
+
+	// If we don't know the state, determine it now.
+	ls, gs := state.state, state.graphemeState
+	if !state.valid {
+		ls, _ = transitionLineBreakState(-1, r, b[length:], "")
+		gs, _ = transitionGraphemeState(grAny, graphemeRune(r, length))
+	}
+
+	// Transition until we find a break opportunity.
+	var (
+		lineBreak int
+		boundary  bool
+	)
+	for {
+		r, l := utf8.DecodeRune(b[length:])
+		ls, lineBreak = transitionLineBreakState(ls, r, b[length+l:], "")
+		gs, boundary = transitionGraphemeState(gs, graphemeRune(r, l))
+
+		if lineBreak == lbMustBreak || lineBreak == lbCanBreak && boundary {
+			return b[:length], b[length:], lineBreak == lbMustBreak, LineState{state: ls, graphemeState: gs, valid: true}
+		}
+
+		length += l
+		if len(b) <= length {
+			return b, nil, true, LineState{} // LB3.
+		}
+	}
+}
+
+// FirstLineSegmentInString is like FirstLineSegment() but its input and
+// outputs are strings.
+func FirstLineSegmentInString(str string, state LineState) (segment, rest string, mustBreak bool, newState LineState) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRuneInString(str)
+	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
+		return str, "", true, LineState{} // LB3.
+	}
+
+	// If we don't know the state, determine it now.
+	ls, gs := state.state, state.graphemeState
+	if !state.valid {
+		ls, _ = transitionLineBreakState(-1, r, nil, str[length:])
+		gs, _ = transitionGraphemeState(grAny, graphemeRune(r, length))
+	}
+
+	// Transition until we find a break opportunity.
+	var (
+		lineBreak int
+		boundary  bool
+	)
+	for {
+		r, l := utf8.DecodeRuneInString(str[length:])
+		ls, lineBreak = transitionLineBreakState(ls, r, nil, str[length+l:])
+		gs, boundary = transitionGraphemeState(gs, graphemeRune(r, l))
+
+		if lineBreak == lbMustBreak || lineBreak == lbCanBreak && boundary {
+			return str[:length], str[length:], lineBreak == lbMustBreak, LineState{state: ls, graphemeState: gs, valid: true}
+		}
+
+		length += l
+		if len(str) <= length {
+			return str, "", true, LineState{} // LB3.
+		}
+	}
+}
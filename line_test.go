@@ -0,0 +1,272 @@
+package uniseg
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+// The test cases for the line break functions.
+var lineBreakOpportunityTestCases = []testCase{
+	{original: "", expected: [][]rune{}},
+	{original: "x", expected: [][]rune{{0x78}}},
+	{original: "Hello, world!", expected: [][]rune{{0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2c, 0x20}, {0x77, 0x6f, 0x72, 0x6c, 0x64, 0x21}}},
+	{original: "one two  three", expected: [][]rune{{0x6f, 0x6e, 0x65, 0x20}, {0x74, 0x77, 0x6f, 0x20, 0x20}, {0x74, 0x68, 0x72, 0x65, 0x65}}},
+	{original: "line\nbreak", expected: [][]rune{{0x6c, 0x69, 0x6e, 0x65, 0xa}, {0x62, 0x72, 0x65, 0x61, 0x6b}}},
+	{original: "crlf\r\nnext", expected: [][]rune{{0x63, 0x72, 0x6c, 0x66, 0xd, 0xa}, {0x6e, 0x65, 0x78, 0x74}}},
+	{original: "well-known", expected: [][]rune{{0x77, 0x65, 0x6c, 0x6c, 0x2d}, {0x6b, 0x6e, 0x6f, 0x77, 0x6e}}},
+	{original: "$(12.5)", expected: [][]rune{{0x24, 0x28, 0x31, 0x32, 0x2e, 0x35, 0x29}}},
+	{original: "a\u00a0b", expected: [][]rune{{0x61, 0xa0, 0x62}}},
+	{original: "x\u200by", expected: [][]rune{{0x78, 0x200b}, {0x79}}},
+	{original: "日本語", expected: [][]rune{{0x65e5}, {0x672c}, {0x8a9e}}},
+	{original: "「引用」です", expected: [][]rune{{0x300c, 0x5f15}, {0x7528, 0x300d}, {0x3067}, {0x3059}}},
+	{original: "e\u0301t\u0301e\u0301", expected: [][]rune{{0x65, 0x301, 0x74, 0x301, 0x65, 0x301}}},
+	{original: "🇩🇪🇫🇷", expected: [][]rune{{0x1f1e9, 0x1f1ea}, {0x1f1eb, 0x1f1f7}}},
+	{original: "👩\u200d👩\u200d👧 family", expected: [][]rune{{0x1f469, 0x200d, 0x1f469, 0x200d, 0x1f467, 0x20}, {0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79}}},
+	{original: "ก ข", expected: [][]rune{{0xe01, 0x20}, {0xe02}}},
+	{original: "#\U0001f3fb", expected: [][]rune{{0x23, 0x1f3fb}}},   // UAX #14 allows a break here but it would split a grapheme cluster.
+	{original: "x \u200d", expected: [][]rune{{0x78, 0x20, 0x200d}}}, // Same.
+}
+
+// Run the line break test cases using the FirstLineSegment function for byte slices.
+func TestLineFunctionBytes(t *testing.T) {
+	for testNum, testCase := range lineBreakOpportunityTestCases {
+		b := []byte(testCase.original)
+		var (
+			state LineState
+			index int
+			w     []byte
+		)
+	SegmentLoop:
+		for len(b) > 0 {
+			w, b, _, state = FirstLineSegment(b, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More segments returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			segment := []rune(string(w))
+			if len(segment) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Segment at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(segment),
+					segment,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range segment {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Segment at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						segment,
+						testCase.expected[index])
+					break SegmentLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer segments returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Run the line break test cases using the FirstLineSegment function for strings.
+func TestLineFunctionString(t *testing.T) {
+	for testNum, testCase := range lineBreakOpportunityTestCases {
+		str := testCase.original
+		var (
+			state LineState
+			index int
+			w     string
+		)
+	SegmentLoop:
+		for len(str) > 0 {
+			w, str, _, state = FirstLineSegmentInString(str, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More segments returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			segment := []rune(w)
+			if len(segment) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Segment at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(segment),
+					segment,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range segment {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Segment at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						segment,
+						testCase.expected[index])
+					break SegmentLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer segments returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the "mustBreak" flag returned by the line break functions.
+func TestLineMustBreak(t *testing.T) {
+	for testNum, testCase := range []struct {
+		original  string
+		mustBreak []bool
+	}{
+		{original: "x", mustBreak: []bool{true}},
+		{original: "one two", mustBreak: []bool{false, true}},
+		{original: "one\ntwo three", mustBreak: []bool{true, false, true}},
+		{original: "one\r\n\r\ntwo", mustBreak: []bool{true, true, true}},
+		{original: "one\u2028two\u0085", mustBreak: []bool{true, true}},
+		{original: "one\vtwo\f", mustBreak: []bool{true, true}},
+	} {
+		var (
+			state     LineState
+			mustBreak bool
+			segment   []byte
+			index     int
+		)
+		b := []byte(testCase.original)
+		for len(b) > 0 {
+			segment, b, mustBreak, state = FirstLineSegment(b, state)
+			if index >= len(testCase.mustBreak) {
+				t.Errorf(`Test case %d %q failed: More segments returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.mustBreak))
+				break
+			}
+			if mustBreak != testCase.mustBreak[index] {
+				t.Errorf(`Test case %d %q failed: Segment %q at index %d has mustBreak=%t, expected %t`,
+					testNum,
+					testCase.original,
+					segment,
+					index,
+					mustBreak,
+					testCase.mustBreak[index])
+			}
+			index++
+		}
+		if index < len(testCase.mustBreak) {
+			t.Errorf(`Test case %d %q failed: Fewer segments returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.mustBreak))
+		}
+	}
+}
+
+// Benchmark the use of the FirstLineSegment function for strings.
+func BenchmarkLineFunctionString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var (
+			w     string
+			state LineState
+		)
+		str := benchmarkStr
+		for len(str) > 0 {
+			w, str, _, state = FirstLineSegmentInString(str, state)
+			resultRunes = []rune(w)
+		}
+	}
+}
+
+// Run the official Unicode test cases against the line break rules. The
+// FirstLineSegment functions never split grapheme clusters, unlike UAX #14,
+// so they can't be tested against these cases directly.
+func TestLineBreakRules(t *testing.T) {
+	for testNum, testCase := range lineBreakTestCases {
+		var (
+			segments [][]rune
+			segment  []rune
+			state    = -1
+		)
+		str := testCase.original
+		for len(str) > 0 {
+			r, length := utf8.DecodeRuneInString(str)
+			str = str[length:]
+			var lineBreak int
+			state, lineBreak = transitionLineBreakState(state, r, nil, str)
+			if lineBreak != lbDontBreak && len(segment) > 0 {
+				segments = append(segments, segment)
+				segment = nil
+			}
+			segment = append(segment, r)
+		}
+		if len(segment) > 0 {
+			segments = append(segments, segment)
+		}
+		if !reflect.DeepEqual(segments, testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Segments are %x, expected %x`,
+				testNum,
+				testCase.original,
+				segments,
+				testCase.expected)
+		}
+
+		// FirstLineSegmentInString() must return the same segments, except
+		// where they would split a grapheme cluster.
+		var expected []string
+		offset := 0
+		for _, segment := range testCase.expected {
+			if len(expected) > 0 && !IsGraphemeBoundary(testCase.original, offset) {
+				expected[len(expected)-1] += string(segment)
+			} else {
+				expected = append(expected, string(segment))
+			}
+			offset += len(string(segment))
+		}
+		var (
+			returned []string
+			ls       LineState
+		)
+		for str = testCase.original; len(str) > 0; {
+			var s string
+			s, str, _, ls = FirstLineSegmentInString(str, ls)
+			returned = append(returned, s)
+		}
+		if !reflect.DeepEqual(returned, expected) {
+			t.Errorf(`Test case %d %q failed: FirstLineSegmentInString() returned %q, expected %q`,
+				testNum,
+				testCase.original,
+				returned,
+				expected)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package uniseg
+
+import "unicode/utf8"
+
+// The states of the line break parser.
+const (
+	lbAny = iota
+	lbBK
+	lbCR
+	lbLF
+	lbNL
+	lbSP
+	lbZW
+	lbWJ
+	lbGL
+	lbBA
+	lbHY
+	lbCL
+	lbCP
+	lbEX
+	lbIS
+	lbSY
+	lbOP
+	lbQU
+	lbQUSP
+	lbNS
+	lbCLCPSP
+	lbB2
+	lbB2SP
+	lbCB
+	lbBB
+	lbLB21a
+	lbHL
+	lbAL
+	lbNU
+	lbPR
+	lbEB
+	lbIDEM
+	lbNUNU
+	lbNUSY
+	lbNUIS
+	lbNUCL
+	lbNUCP
+	lbPO
+	lbJL
+	lbJV
+	lbJT
+	lbH2
+	lbH3
+	lbOddRI
+	lbEvenRI
+	lbExtPicCn
+	lbZWJBit     = 64  // This bit is set for any states followed by at least one zero-width joiner (see LB8a).
+	lbCPeaFWHBit = 128 // This bit is set for CP states whose East Asian Width is not F, W, or H (see LB30).
+)
+
+// The line break parser's breaking instructions.
+const (
+	lbDontBreak = iota // No break opportunity.
+	lbCanBreak         // A line may be broken here.
+	lbMustBreak        // A line must be broken here.
+)
+
+// The line break parser's state transitions. It's analogous to grTransitions,
+// see comments there for details. The state -1 denotes the start of the text,
+// it never appears in this map. The treatment of combining marks (LB9, LB10),
+// rules which require looking ahead (LB25), rules which depend on other
+// properties (LB1, LB30, LB30b), and the handling of regional indicators
+// (LB30a) are implemented in transitionLineBreakState().
+var lbTransitions = map[[2]int][3]int{
+	// LB4.
+	{lbBK, prAny}: {lbAny, lbMustBreak, 40},
+
+	// LB5.
+	{lbCR, prLF}:  {lbLF, lbDontBreak, 50},
+	{lbCR, prAny}: {lbAny, lbMustBreak, 50},
+	{lbLF, prAny}: {lbAny, lbMustBreak, 50},
+	{lbNL, prAny}: {lbAny, lbMustBreak, 50},
+
+	// LB6.
+	{lbAny, prBK}: {lbBK, lbDontBreak, 60},
+	{lbAny, prCR}: {lbCR, lbDontBreak, 60},
+	{lbAny, prLF}: {lbLF, lbDontBreak, 60},
+	{lbAny, prNL}: {lbNL, lbDontBreak, 60},
+
+	// LB7.
+	{lbAny, prSP}: {lbSP, lbDontBreak, 70},
+	{lbAny, prZW}: {lbZW, lbDontBreak, 70},
+
+	// LB8.
+	{lbZW, prSP}:  {lbZW, lbDontBreak, 70},
+	{lbZW, prAny}: {lbAny, lbCanBreak, 80},
+
+	// LB11.
+	{lbAny, prWJ}: {lbWJ, lbDontBreak, 110},
+	{lbWJ, prAny}: {lbAny, lbDontBreak, 110},
+
+	// LB12.
+	{lbAny, prGL}: {lbGL, lbCanBreak, 310},
+	{lbGL, prAny}: {lbAny, lbDontBreak, 120},
+
+	// LB13 (simple transitions).
+	{lbAny, prCL}: {lbCL, lbCanBreak, 310},
+	{lbAny, prCP}: {lbCP, lbCanBreak, 310},
+	{lbAny, prEX}: {lbEX, lbDontBreak, 130},
+	{lbAny, prIS}: {lbIS, lbCanBreak, 310},
+	{lbAny, prSY}: {lbSY, lbCanBreak, 310},
+
+	// LB14.
+	{lbAny, prOP}: {lbOP, lbCanBreak, 310},
+	{lbOP, prSP}:  {lbOP, lbDontBreak, 70},
+	{lbOP, prAny}: {lbAny, lbDontBreak, 140},
+
+	// LB15.
+	{lbQU, prSP}:   {lbQUSP, lbDontBreak, 70},
+	{lbQU, prOP}:   {lbOP, lbDontBreak, 150},
+	{lbQUSP, prOP}: {lbOP, lbDontBreak, 150},
+
+	// LB16.
+	{lbCL, prSP}:     {lbCLCPSP, lbDontBreak, 70},
+	{lbNUCL, prSP}:   {lbCLCPSP, lbDontBreak, 70},
+	{lbCP, prSP}:     {lbCLCPSP, lbDontBreak, 70},
+	{lbNUCP, prSP}:   {lbCLCPSP, lbDontBreak, 70},
+	{lbCL, prNS}:     {lbNS, lbDontBreak, 160},
+	{lbNUCL, prNS}:   {lbNS, lbDontBreak, 160},
+	{lbCP, prNS}:     {lbNS, lbDontBreak, 160},
+	{lbNUCP, prNS}:   {lbNS, lbDontBreak, 160},
+	{lbCLCPSP, prNS}: {lbNS, lbDontBreak, 160},
+
+	// LB17.
+	{lbAny, prB2}:  {lbB2, lbCanBreak, 310},
+	{lbB2, prSP}:   {lbB2SP, lbDontBreak, 70},
+	{lbB2, prB2}:   {lbB2, lbDontBreak, 170},
+	{lbB2SP, prB2}: {lbB2, lbDontBreak, 170},
+
+	// LB18.
+	{lbSP, prAny}:     {lbAny, lbCanBreak, 180},
+	{lbQUSP, prAny}:   {lbAny, lbCanBreak, 180},
+	{lbCLCPSP, prAny}: {lbAny, lbCanBreak, 180},
+	{lbB2SP, prAny}:   {lbAny, lbCanBreak, 180},
+
+	// LB19.
+	{lbAny, prQU}: {lbQU, lbDontBreak, 190},
+	{lbQU, prAny}: {lbAny, lbDontBreak, 190},
+
+	// LB20.
+	{lbAny, prCB}: {lbCB, lbCanBreak, 200},
+	{lbCB, prAny}: {lbAny, lbCanBreak, 200},
+
+	// LB21.
+	{lbAny, prBA}: {lbBA, lbDontBreak, 210},
+	{lbAny, prHY}: {lbHY, lbDontBreak, 210},
+	{lbAny, prNS}: {lbNS, lbDontBreak, 210},
+	{lbAny, prBB}: {lbBB, lbCanBreak, 310},
+	{lbBB, prAny}: {lbAny, lbDontBreak, 210},
+
+	// LB21a.
+	{lbAny, prHL}:    {lbHL, lbCanBreak, 310},
+	{lbHL, prHY}:     {lbLB21a, lbDontBreak, 210},
+	{lbHL, prBA}:     {lbLB21a, lbDontBreak, 210},
+	{lbLB21a, prAny}: {lbAny, lbDontBreak, 211},
+
+	// LB21b.
+	{lbSY, prHL}:   {lbHL, lbDontBreak, 212},
+	{lbNUSY, prHL}: {lbHL, lbDontBreak, 212},
+
+	// LB22.
+	{lbAny, prIN}: {lbAny, lbDontBreak, 220},
+
+	// LB23.
+	{lbAny, prAL}:  {lbAL, lbCanBreak, 310},
+	{lbAny, prNU}:  {lbNU, lbCanBreak, 310},
+	{lbAL, prNU}:   {lbNU, lbDontBreak, 230},
+	{lbHL, prNU}:   {lbNU, lbDontBreak, 230},
+	{lbNU, prAL}:   {lbAL, lbDontBreak, 230},
+	{lbNU, prHL}:   {lbHL, lbDontBreak, 230},
+	{lbNUNU, prAL}: {lbAL, lbDontBreak, 230},
+	{lbNUNU, prHL}: {lbHL, lbDontBreak, 230},
+
+	// LB23a.
+	{lbAny, prPR}:  {lbPR, lbCanBreak, 310},
+	{lbAny, prID}:  {lbIDEM, lbCanBreak, 310},
+	{lbAny, prEB}:  {lbEB, lbCanBreak, 310},
+	{lbAny, prEM}:  {lbIDEM, lbCanBreak, 310},
+	{lbPR, prID}:   {lbIDEM, lbDontBreak, 231},
+	{lbPR, prEB}:   {lbEB, lbDontBreak, 231},
+	{lbPR, prEM}:   {lbIDEM, lbDontBreak, 231},
+	{lbIDEM, prPO}: {lbPO, lbDontBreak, 231},
+	{lbEB, prPO}:   {lbPO, lbDontBreak, 231},
+
+	// LB24.
+	{lbAny, prPO}: {lbPO, lbCanBreak, 310},
+	{lbPR, prAL}:  {lbAL, lbDontBreak, 240},
+	{lbPR, prHL}:  {lbHL, lbDontBreak, 240},
+	{lbPO, prAL}:  {lbAL, lbDontBreak, 240},
+	{lbPO, prHL}:  {lbHL, lbDontBreak, 240},
+	{lbAL, prPR}:  {lbPR, lbDontBreak, 240},
+	{lbAL, prPO}:  {lbPO, lbDontBreak, 240},
+	{lbHL, prPR}:  {lbPR, lbDontBreak, 240},
+	{lbHL, prPO}:  {lbPO, lbDontBreak, 240},
+
+	// LB25 (simple transitions).
+	{lbPR, prNU}:   {lbNU, lbDontBreak, 250},
+	{lbPO, prNU}:   {lbNU, lbDontBreak, 250},
+	{lbOP, prNU}:   {lbNU, lbDontBreak, 250},
+	{lbHY, prNU}:   {lbNU, lbDontBreak, 250},
+	{lbNU, prNU}:   {lbNUNU, lbDontBreak, 250},
+	{lbNU, prSY}:   {lbNUSY, lbDontBreak, 250},
+	{lbNU, prIS}:   {lbNUIS, lbDontBreak, 250},
+	{lbNUNU, prNU}: {lbNUNU, lbDontBreak, 250},
+	{lbNUNU, prSY}: {lbNUSY, lbDontBreak, 250},
+	{lbNUNU, prIS}: {lbNUIS, lbDontBreak, 250},
+	{lbNUSY, prNU}: {lbNUNU, lbDontBreak, 250},
+	{lbNUSY, prSY}: {lbNUSY, lbDontBreak, 250},
+	{lbNUSY, prIS}: {lbNUIS, lbDontBreak, 250},
+	{lbNUIS, prNU}: {lbNUNU, lbDontBreak, 250},
+	{lbNUIS, prSY}: {lbNUSY, lbDontBreak, 250},
+	{lbNUIS, prIS}: {lbNUIS, lbDontBreak, 250},
+	{lbNU, prCL}:   {lbNUCL, lbDontBreak, 250},
+	{lbNU, prCP}:   {lbNUCP, lbDontBreak, 250},
+	{lbNUNU, prCL}: {lbNUCL, lbDontBreak, 250},
+	{lbNUNU, prCP}: {lbNUCP, lbDontBreak, 250},
+	{lbNUSY, prCL}: {lbNUCL, lbDontBreak, 250},
+	{lbNUSY, prCP}: {lbNUCP, lbDontBreak, 250},
+	{lbNUIS, prCL}: {lbNUCL, lbDontBreak, 250},
+	{lbNUIS, prCP}: {lbNUCP, lbDontBreak, 250},
+	{lbNU, prPO}:   {lbPO, lbDontBreak, 250},
+	{lbNUNU, prPO}: {lbPO, lbDontBreak, 250},
+	{lbNUSY, prPO}: {lbPO, lbDontBreak, 250},
+	{lbNUIS, prPO}: {lbPO, lbDontBreak, 250},
+	{lbNUCL, prPO}: {lbPO, lbDontBreak, 250},
+	{lbNUCP, prPO}: {lbPO, lbDontBreak, 250},
+	{lbNU, prPR}:   {lbPR, lbDontBreak, 250},
+	{lbNUNU, prPR}: {lbPR, lbDontBreak, 250},
+	{lbNUSY, prPR}: {lbPR, lbDontBreak, 250},
+	{lbNUIS, prPR}: {lbPR, lbDontBreak, 250},
+	{lbNUCL, prPR}: {lbPR, lbDontBreak, 250},
+	{lbNUCP, prPR}: {lbPR, lbDontBreak, 250},
+
+	// LB26.
+	{lbAny, prJL}: {lbJL, lbCanBreak, 310},
+	{lbAny, prJV}: {lbJV, lbCanBreak, 310},
+	{lbAny, prJT}: {lbJT, lbCanBreak, 310},
+	{lbAny, prH2}: {lbH2, lbCanBreak, 310},
+	{lbAny, prH3}: {lbH3, lbCanBreak, 310},
+	{lbJL, prJL}:  {lbJL, lbDontBreak, 260},
+	{lbJL, prJV}:  {lbJV, lbDontBreak, 260},
+	{lbJL, prH2}:  {lbH2, lbDontBreak, 260},
+	{lbJL, prH3}:  {lbH3, lbDontBreak, 260},
+	{lbJV, prJV}:  {lbJV, lbDontBreak, 260},
+	{lbJV, prJT}:  {lbJT, lbDontBreak, 260},
+	{lbH2, prJV}:  {lbJV, lbDontBreak, 260},
+	{lbH2, prJT}:  {lbJT, lbDontBreak, 260},
+	{lbJT, prJT}:  {lbJT, lbDontBreak, 260},
+	{lbH3, prJT}:  {lbJT, lbDontBreak, 260},
+
+	// LB27.
+	{lbJL, prPO}: {lbPO, lbDontBreak, 270},
+	{lbJV, prPO}: {lbPO, lbDontBreak, 270},
+	{lbJT, prPO}: {lbPO, lbDontBreak, 270},
+	{lbH2, prPO}: {lbPO, lbDontBreak, 270},
+	{lbH3, prPO}: {lbPO, lbDontBreak, 270},
+	{lbPR, prJL}: {lbJL, lbDontBreak, 270},
+	{lbPR, prJV}: {lbJV, lbDontBreak, 270},
+	{lbPR, prJT}: {lbJT, lbDontBreak, 270},
+	{lbPR, prH2}: {lbH2, lbDontBreak, 270},
+	{lbPR, prH3}: {lbH3, lbDontBreak, 270},
+
+	// LB28.
+	{lbAL, prAL}: {lbAL, lbDontBreak, 280},
+	{lbAL, prHL}: {lbHL, lbDontBreak, 280},
+	{lbHL, prAL}: {lbAL, lbDontBreak, 280},
+	{lbHL, prHL}: {lbHL, lbDontBreak, 280},
+
+	// LB29.
+	{lbIS, prAL}:   {lbAL, lbDontBreak, 290},
+	{lbIS, prHL}:   {lbHL, lbDontBreak, 290},
+	{lbNUIS, prAL}: {lbAL, lbDontBreak, 290},
+	{lbNUIS, prHL}: {lbHL, lbDontBreak, 290},
+
+	// LB30b.
+	{lbEB, prEM}:       {lbIDEM, lbDontBreak, 302},
+	{lbExtPicCn, prEM}: {lbIDEM, lbDontBreak, 302},
+}
+
+// transitionLineBreakState determines the new state of the line break parser
+// given the current state and the next code point. It also returns the type of
+// line break: lbDontBreak, lbCanBreak, or lbMustBreak. A state of -1 denotes
+// the start of the text. If more than one code point is needed to determine
+// the new state, the byte slice or the string starting after rune "r" can be
+// used (whichever is not nil or empty) for further lookups.
+func transitionLineBreakState(state int, r rune, b []byte, str string) (newState int, lineBreak int) {
+	// Determine the property of the next character.
+	nextProperty, generalCategory := propertyWithGenCat(lineBreakCodePoints, r)
+
+	// Extract the bits which are not part of the state proper.
+	var forceNoBreak, isCPeaFWH bool
+	if state >= 0 {
+		isCPeaFWH = state&lbCPeaFWHBit != 0 // LB30: CP but ea is not F, W, or H.
+		forceNoBreak = state&lbZWJBit != 0  // LB8a.
+		state &^= lbCPeaFWHBit | lbZWJBit
+	}
+
+	// LB1.
+	switch nextProperty {
+	case prAny, prAI, prSG, prXX:
+		nextProperty = prAL
+	case prSA:
+		if generalCategory == gcMn || generalCategory == gcMc {
+			nextProperty = prCM
+		} else {
+			nextProperty = prAL
+		}
+	case prCJ:
+		nextProperty = prNS
+	}
+
+	// Combining marks.
+	if nextProperty == prZWJ || nextProperty == prCM {
+		var bit int
+		if nextProperty == prZWJ {
+			bit = lbZWJBit
+		}
+		mustBreakState := state < 0 || state == lbBK || state == lbCR || state == lbLF || state == lbNL
+		if !mustBreakState && state != lbSP && state != lbZW && state != lbQUSP && state != lbCLCPSP && state != lbB2SP {
+			// LB9.
+			if isCPeaFWH {
+				bit |= lbCPeaFWHBit
+			}
+			return state | bit, lbDontBreak
+		}
+
+		// LB10.
+		if mustBreakState {
+			return lbAL | bit, lbMustBreak
+		}
+		return lbAL | bit, lbCanBreak
+	}
+
+	defer func() {
+		// Transition into LB30.
+		if newState == lbCP || newState == lbNUCP {
+			ea := property(eastAsianWidth, r)
+			if ea != prF && ea != prW && ea != prH {
+				newState |= lbCPeaFWHBit
+			}
+		}
+
+		// Override break.
+		if forceNoBreak {
+			lineBreak = lbDontBreak
+		}
+	}()
+
+	// Find the applicable transition.
+	var rule int
+	transition, ok := lbTransitions[[2]int{state, nextProperty}]
+	if ok {
+		// We have a specific transition. We'll use it.
+		newState, lineBreak, rule = transition[0], transition[1], transition[2]
+	} else {
+		// No specific transition found. Try the less specific ones.
+		transAnyProp, okAnyProp := lbTransitions[[2]int{state, prAny}]
+		transAnyState, okAnyState := lbTransitions[[2]int{lbAny, nextProperty}]
+		if okAnyProp && okAnyState {
+			// Both apply. We'll use a mix (see comments for grTransitions).
+			newState, lineBreak, rule = transAnyState[0], transAnyState[1], transAnyState[2]
+			if transAnyProp[2] < transAnyState[2] {
+				lineBreak, rule = transAnyProp[1], transAnyProp[2]
+			}
+		} else if okAnyProp {
+			// We only have a specific state.
+			newState, lineBreak, rule = transAnyProp[0], transAnyProp[1], transAnyProp[2]
+		} else if okAnyState {
+			// We only have a specific property.
+			newState, lineBreak, rule = transAnyState[0], transAnyState[1], transAnyState[2]
+		} else {
+			// No known transition. LB31: ALL ÷ ALL.
+			newState, lineBreak, rule = lbAny, lbCanBreak, 310
+		}
+	}
+
+	// LB12a.
+	if rule > 121 &&
+		nextProperty == prGL &&
+		state != lbSP && state != lbBA && state != lbHY && state != lbLB21a && state != lbQUSP && state != lbCLCPSP && state != lbB2SP {
+		return lbGL, lbDontBreak
+	}
+
+	// LB13.
+	if rule > 130 && state != lbNU && state != lbNUNU {
+		switch nextProperty {
+		case prCL:
+			return lbCL, lbDontBreak
+		case prCP:
+			return lbCP, lbDontBreak
+		case prIS:
+			return lbIS, lbDontBreak
+		case prSY:
+			return lbSY, lbDontBreak
+		}
+	}
+
+	// LB25 (look ahead).
+	if rule > 250 &&
+		(state == lbPR || state == lbPO) &&
+		(nextProperty == prOP || nextProperty == prHY) {
+		var r rune
+		if b != nil { // Byte slice version.
+			r, _ = utf8.DecodeRune(b)
+		} else { // String version.
+			r, _ = utf8.DecodeRuneInString(str)
+		}
+		if r != utf8.RuneError {
+			if pr, _ := propertyWithGenCat(lineBreakCodePoints, r); pr == prNU {
+				return lbNU, lbDontBreak
+			}
+		}
+	}
+
+	// LB30 (part one).
+	if rule > 300 {
+		if (state == lbAL || state == lbHL || state == lbNU || state == lbNUNU) && nextProperty == prOP {
+			ea := property(eastAsianWidth, r)
+			if ea != prF && ea != prW && ea != prH {
+				return lbOP, lbDontBreak
+			}
+		} else if isCPeaFWH {
+			switch nextProperty {
+			case prAL:
+				return lbAL, lbDontBreak
+			case prHL:
+				return lbHL, lbDontBreak
+			case prNU:
+				return lbNU, lbDontBreak
+			}
+		}
+	}
+
+	// LB30a.
+	if newState == lbAny && nextProperty == prRI {
+		if state == lbOddRI {
+			// Don't break pairs of Regional Indicators.
+			return lbEvenRI, lbDontBreak
+		}
+		return lbOddRI, lineBreak // We can break before the first and after a pair.
+	}
+
+	// LB30b. Unassigned Extended_Pictographic code points are remembered.
+	if newState == lbIDEM && generalCategory == gcCn && graphemeProperty(r) == prExtendedPictographic {
+		newState = lbExtPicCn
+	}
+
+	return
+}
@@ -1,7 +1,12 @@
 package uniseg
 
-// The unicode properties. Only the ones needed in the context of this package
-// are included.
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// The Unicode properties as used in the various parsers. Only the ones needed
+// in the context of this package are included.
 const (
 	prAny = iota
 	prPrepend
@@ -18,17 +23,133 @@ const (
 	prLVT
 	prZWJ
 	prExtendedPictographic
+	prNewline
+	prWSegSpace
+	prDoubleQuote
+	prSingleQuote
+	prMidNumLet
+	prNumeric
+	prMidLetter
+	prMidNum
+	prExtendNumLet
+	prALetter
+	prFormat
+	prHebrewLetter
+	prKatakana
+	prSp
+	prSTerm
+	prClose
+	prSContinue
+	prATerm
+	prUpper
+	prLower
+	prSep
+	prOLetter
+	prBK
+	prCM
+	prNL
+	prSG
+	prWJ
+	prZW
+	prGL
+	prSP
+	prB2
+	prBA
+	prBB
+	prHY
+	prCB
+	prCL
+	prCP
+	prEX
+	prIN
+	prNS
+	prOP
+	prQU
+	prIS
+	prNU
+	prPO
+	prPR
+	prSY
+	prAI
+	prAL
+	prCJ
+	prEB
+	prEM
+	prH2
+	prH3
+	prHL
+	prID
+	prJL
+	prJV
+	prJT
+	prRI
+	prSA
+	prXX
+	prN
+	prNa
+	prA
+	prW
+	prH
+	prF
+	prEmojiPresentation
+)
+
+// Indic_Conjunct_Break (InCB) property values, introduced with Unicode 15.1.
+// In the grapheme property tables, they are added to the grapheme cluster
+// break properties, which occupy the lower four bits.
+const (
+	incbNone      = 0
+	incbLinker    = 1 << 4
+	incbConsonant = 2 << 4
+	incbExtend    = 3 << 4
+	incbMask      = 3 << 4
+)
+
+// Unicode General Categories. Only the ones needed in the context of this
+// package are included.
+const (
+	gcNone = iota // gcNone must be 0.
+	gcCc
+	gcZs
+	gcPo
+	gcSc
+	gcPs
+	gcPe
+	gcSm
+	gcPd
+	gcNd
+	gcLu
+	gcSk
+	gcPc
+	gcLl
+	gcSo
+	gcLo
+	gcPi
+	gcCf
+	gcNo
+	gcPf
+	gcLC
+	gcLm
+	gcMn
+	gcMe
+	gcMc
+	gcNl
+	gcZl
+	gcZp
+	gcCn
+	gcCs
+	gcCo
 )
 
 // property returns the Unicode property value (see constants above) of the
-// given code point.
-func property(r rune) int {
+// given code point, as listed in the given code point table.
+func property(dictionary [][3]int, r rune) int {
 	// Run a binary search.
 	from := 0
-	to := len(graphemeCodePoints)
+	to := len(dictionary)
 	for to > from {
 		middle := (from + to) / 2
-		cpRange := graphemeCodePoints[middle]
+		cpRange := dictionary[middle]
 		if int(r) < cpRange[0] {
 			to = middle
 			continue
@@ -41,3 +162,121 @@ func property(r rune) int {
 	}
 	return prAny
 }
+
+// The number of bits of a code point used to index the second and the third
+// stage of the grapheme property lookup tables. This must match the value used
+// by gen_properties.go.
+const trieBlockBits = 6
+
+// graphemeProperty returns the grapheme cluster break property (see constants
+// above) of the given code point.
+func graphemeProperty(r rune) int {
+	return graphemeValue(r) &^ incbMask
+}
+
+// graphemeValue returns the value stored for the given code point in the
+// grapheme property tables, i.e. its grapheme cluster break property plus its
+// Indic_Conjunct_Break property (see constants above). It is an O(1) lookup in
+// the generated tables in graphemeproperties_unicode*.go: Latin-1 code points
+// are looked up directly, all others via a three-stage table.
+func graphemeValue(r rune) int {
+	if r >= 0 && r < 0x100 {
+		return int(graphemeLatin1[r])
+	}
+	if r < 0 || r > utf8.MaxRune {
+		return prAny
+	}
+	const mask = 1<<trieBlockBits - 1
+	block := int(graphemeStage1[r>>(2*trieBlockBits)])<<trieBlockBits | int(r>>trieBlockBits)&mask
+	return int(graphemeStage3[int(graphemeStage2[block])<<trieBlockBits|int(r)&mask])
+}
+
+// propertyWithGenCat returns the Unicode property value and General Category
+// (see constants above) of the given code point, as listed in the given code
+// point table.
+func propertyWithGenCat(dictionary [][4]int, r rune) (property, generalCategory int) {
+	// Run a binary search.
+	from := 0
+	to := len(dictionary)
+	for to > from {
+		middle := (from + to) / 2
+		cpRange := dictionary[middle]
+		if int(r) < cpRange[0] {
+			to = middle
+			continue
+		}
+		if int(r) > cpRange[1] {
+			from = middle + 1
+			continue
+		}
+		return cpRange[2], cpRange[3]
+	}
+	return prAny, gcNone
+}
+
+// GraphemeBreakProperty is the Grapheme_Cluster_Break property of a code point
+// as used by the grapheme cluster functions of this package, see
+// https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Break_Property_Values.
+// Its String() function returns the property value's name as listed in the
+// Unicode Character Database, e.g. "Regional_Indicator".
+//
+// Code points with the Extended_Pictographic property are reported as
+// GraphemeBreakExtendedPictographic instead of their Grapheme_Cluster_Break
+// value (which is always Other) because rule GB11 treats them separately.
+type GraphemeBreakProperty int
+
+// The values of the GraphemeBreakProperty type.
+const (
+	GraphemeBreakOther                GraphemeBreakProperty = prAny
+	GraphemeBreakPrepend              GraphemeBreakProperty = prPrepend
+	GraphemeBreakCR                   GraphemeBreakProperty = prCR
+	GraphemeBreakLF                   GraphemeBreakProperty = prLF
+	GraphemeBreakControl              GraphemeBreakProperty = prControl
+	GraphemeBreakExtend               GraphemeBreakProperty = prExtend
+	GraphemeBreakRegionalIndicator    GraphemeBreakProperty = prRegionalIndicator
+	GraphemeBreakSpacingMark          GraphemeBreakProperty = prSpacingMark
+	GraphemeBreakL                    GraphemeBreakProperty = prL
+	GraphemeBreakV                    GraphemeBreakProperty = prV
+	GraphemeBreakT                    GraphemeBreakProperty = prT
+	GraphemeBreakLV                   GraphemeBreakProperty = prLV
+	GraphemeBreakLVT                  GraphemeBreakProperty = prLVT
+	GraphemeBreakZWJ                  GraphemeBreakProperty = prZWJ
+	GraphemeBreakExtendedPictographic GraphemeBreakProperty = prExtendedPictographic
+)
+
+// graphemeBreakPropertyNames maps grapheme cluster break properties to their
+// names in the Unicode Character Database.
+var graphemeBreakPropertyNames = [...]string{
+	GraphemeBreakOther:                "Other",
+	GraphemeBreakPrepend:              "Prepend",
+	GraphemeBreakCR:                   "CR",
+	GraphemeBreakLF:                   "LF",
+	GraphemeBreakControl:              "Control",
+	GraphemeBreakExtend:               "Extend",
+	GraphemeBreakRegionalIndicator:    "Regional_Indicator",
+	GraphemeBreakSpacingMark:          "SpacingMark",
+	GraphemeBreakL:                    "L",
+	GraphemeBreakV:                    "V",
+	GraphemeBreakT:                    "T",
+	GraphemeBreakLV:                   "LV",
+	GraphemeBreakLVT:                  "LVT",
+	GraphemeBreakZWJ:                  "ZWJ",
+	GraphemeBreakExtendedPictographic: "Extended_Pictographic",
+}
+
+// String returns the name of the property value as listed in the Unicode
+// Character Database, e.g. "SpacingMark".
+func (p GraphemeBreakProperty) String() string {
+	if p < 0 || int(p) >= len(graphemeBreakPropertyNames) {
+		return "GraphemeBreakProperty(" + strconv.Itoa(int(p)) + ")"
+	}
+	return graphemeBreakPropertyNames[p]
+}
+
+// GraphemeProperty returns the grapheme cluster break property of the given
+// code point, as used by the grapheme cluster functions of this package for
+// the Unicode version selected at build time (see UnicodeVersion). Invalid code
+// points return GraphemeBreakOther.
+func GraphemeProperty(r rune) GraphemeBreakProperty {
+	return GraphemeBreakProperty(graphemeProperty(r))
+}
@@ -0,0 +1,199 @@
+package uniseg
+
+import "unicode/utf8"
+
+// Sentences implements an iterator over sentences, as specified in the Unicode
+// Standard Annex #29, Sentence Boundaries. Any trailing spaces and paragraph
+// separators are included in the sentence they follow.
+type Sentences struct {
+	// The original string.
+	original string
+
+	// The part of the original string which has not been parsed yet.
+	remaining string
+
+	// The byte positions of the current sentence in the original string. If
+	// start == end, we either haven't started iterating yet (0) or the
+	// iteration has already completed (len(original)).
+	start, end int
+
+	// The current state of the sentence break parser.
+	state SentenceState
+}
+
+// NewSentences returns a new sentence iterator.
+func NewSentences(s string) *Sentences {
+	return &Sentences{
+		original:  s,
+		remaining: s,
+	}
+}
+
+// Next advances the iterator by one sentence and returns false if no sentences
+// are left. This function must be called before the first sentence is
+// accessed.
+func (s *Sentences) Next() bool {
+	if len(s.remaining) == 0 {
+		// We're already past the end.
+		s.start, s.end = len(s.original), len(s.original)
+		return false
+	}
+	var sentence string
+	sentence, s.remaining, s.state = FirstSentenceInString(s.remaining, s.state)
+	s.start = len(s.original) - len(s.remaining) - len(sentence)
+	s.end = s.start + len(sentence)
+	return true
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// sentence. If the iterator is already past the end or Next() has not yet been
+// called, nil is returned.
+func (s *Sentences) Runes() []rune {
+	if s.start == s.end {
+		return nil
+	}
+	return []rune(s.original[s.start:s.end])
+}
+
+// Str returns a substring of the original string which corresponds to the
+// current sentence. If the iterator is already past the end or Next() has not
+// yet been called, an empty string is returned.
+func (s *Sentences) Str() string {
+	if s.start == s.end {
+		return ""
+	}
+	return s.original[s.start:s.end]
+}
+
+// Bytes returns a byte slice which corresponds to the current sentence. If the
+// iterator is already past the end or Next() has not yet been called, nil is
+// returned.
+func (s *Sentences) Bytes() []byte {
+	if s.start == s.end {
+		return nil
+	}
+	return []byte(s.original[s.start:s.end])
+}
+
+// Positions returns the interval of the current sentence as byte positions into
+// the original string. The first returned value "from" indexes the first byte
+// and the second returned value "to" indexes the first byte that is not
+// included anymore, i.e. str[from:to] is the current sentence of the original
+// string "str". If Next() has not yet been called, both values are 0. If the
+// iterator is already past the end, both values are len(str).
+func (s *Sentences) Positions() (int, int) {
+	return s.start, s.end
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// Next() sets it to the first sentence again.
+func (s *Sentences) Reset() {
+	s.remaining = s.original
+	s.start, s.end = 0, 0
+	s.state = SentenceState{}
+}
+
+// SentenceState holds the state of the sentence break parser between calls to
+// FirstSentence() or FirstSentenceInString(). Its zero value represents an
+// unknown state, which is what must be passed when parsing starts. Its fields
+// are internal and may change in future versions.
+type SentenceState struct {
+	// The state of the sentence break parser after the first code point of the
+	// next sentence has been processed.
+	state int
+
+	// Whether or not "state" contains a valid parser state.
+	valid bool
+}
+
+// FirstSentence returns the first sentence (as a slice of bytes) found in the
+// given byte slice according to the rules of Unicode Standard Annex #29,
+// Sentence Boundaries. This function can be called continuously to extract all
+// sentences from a byte slice, as follows:
+//
+//   var state uniseg.SentenceState
+//   for len(b) > 0 {
+//       s, b, state = uniseg.FirstSentence(b, state)
+//       // Do something with s.
+//   }
+//
+// If you don't know the current state, for example when calling the function
+// for the first time, you must pass the zero value of SentenceState. For
+// consecutive calls, you should pass the state returned by the previous call.
+//
+// The "rest" slice is the subslice of the original byte slice "b" starting
+// after the last byte of the identified sentence. If the length of the "rest"
+// slice is 0, the entire byte slice "b" has been processed.
+//
+// For an empty byte slice "b", the function returns nil values.
+func FirstSentence(b []byte, state SentenceState) (sentence, rest []byte, newState SentenceState) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRune(b)
+	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
+		return b, nil, SentenceState{}
+	}
+
+	// If we don't know the state, determine it now.
+	s := state.state
+	if !state.valid {
+		s, _ = transitionSentenceBreakState(sbAny, r, b[length:], "")
+	}
+
+	// Transition until we find a boundary.
+	var boundary bool
+	for {
+		r, l := utf8.DecodeRune(b[length:])
+		s, boundary = transitionSentenceBreakState(s, r, b[length+l:], "")
+
+		if boundary {
+			return b[:length], b[length:], SentenceState{state: s, valid: true}
+		}
+
+		length += l
+		if len(b) <= length {
+			return b, nil, SentenceState{}
+		}
+	}
+}
+
+// FirstSentenceInString is like FirstSentence() but its input and outputs are
+// strings.
+func FirstSentenceInString(str string, state SentenceState) (sentence, rest string, newState SentenceState) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRuneInString(str)
+	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
+		return str, "", SentenceState{}
+	}
+
+	// If we don't know the state, determine it now.
+	s := state.state
+	if !state.valid {
+		s, _ = transitionSentenceBreakState(sbAny, r, nil, str[length:])
+	}
+
+	// Transition until we find a boundary.
+	var boundary bool
+	for {
+		r, l := utf8.DecodeRuneInString(str[length:])
+		s, boundary = transitionSentenceBreakState(s, r, nil, str[length+l:])
+
+		if boundary {
+			return str[:length], str[length:], SentenceState{state: s, valid: true}
+		}
+
+		length += l
+		if len(str) <= length {
+			return str, "", SentenceState{}
+		}
+	}
+}
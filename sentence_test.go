@@ -0,0 +1,308 @@
+package uniseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+// The test cases for the sentence functions.
+var sentenceTestCases = []testCase{
+	{original: "", expected: [][]rune{}},
+	{original: "x", expected: [][]rune{{0x78}}},
+	{original: "Hello. World!", expected: [][]rune{{0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x20}, {0x57, 0x6f, 0x72, 0x6c, 0x64, 0x21}}},
+	{original: "Mr. Smith went to Washington.", expected: [][]rune{{0x4d, 0x72, 0x2e, 0x20}, {0x53, 0x6d, 0x69, 0x74, 0x68, 0x20, 0x77, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x57, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x74, 0x6f, 0x6e, 0x2e}}},
+	{original: "e.g. this one", expected: [][]rune{{0x65, 0x2e, 0x67, 0x2e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x65}}},
+	{original: "He said \"Stop.\" Then he left.", expected: [][]rune{{0x48, 0x65, 0x20, 0x73, 0x61, 0x69, 0x64, 0x20, 0x22, 0x53, 0x74, 0x6f, 0x70, 0x2e, 0x22, 0x20}, {0x54, 0x68, 0x65, 0x6e, 0x20, 0x68, 0x65, 0x20, 0x6c, 0x65, 0x66, 0x74, 0x2e}}},
+	{original: "Is it? Yes! (Really.) Okay.", expected: [][]rune{{0x49, 0x73, 0x20, 0x69, 0x74, 0x3f, 0x20}, {0x59, 0x65, 0x73, 0x21, 0x20}, {0x28, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x29, 0x20}, {0x4f, 0x6b, 0x61, 0x79, 0x2e}}},
+	{original: "Wait... what?", expected: [][]rune{{0x57, 0x61, 0x69, 0x74, 0x2e, 0x2e, 0x2e, 0x20, 0x77, 0x68, 0x61, 0x74, 0x3f}}},
+	{original: "3.14 is pi.", expected: [][]rune{{0x33, 0x2e, 0x31, 0x34, 0x20, 0x69, 0x73, 0x20, 0x70, 0x69, 0x2e}}},
+	{original: "Etc.) now", expected: [][]rune{{0x45, 0x74, 0x63, 0x2e, 0x29, 0x20, 0x6e, 0x6f, 0x77}}},
+	{original: "第一句。第二句。", expected: [][]rune{{0x7b2c, 0x4e00, 0x53e5, 0x3002}, {0x7b2c, 0x4e8c, 0x53e5, 0x3002}}},
+	{original: "Line one\nLine two", expected: [][]rune{{0x4c, 0x69, 0x6e, 0x65, 0x20, 0x6f, 0x6e, 0x65, 0xa}, {0x4c, 0x69, 0x6e, 0x65, 0x20, 0x74, 0x77, 0x6f}}},
+	{original: "End.\r\nNext.", expected: [][]rune{{0x45, 0x6e, 0x64, 0x2e, 0xd, 0xa}, {0x4e, 0x65, 0x78, 0x74, 0x2e}}},
+	{original: "Wow!  \nNext", expected: [][]rune{{0x57, 0x6f, 0x77, 0x21, 0x20, 0x20, 0xa}, {0x4e, 0x65, 0x78, 0x74}}},
+	{original: "U.S.A. is big.", expected: [][]rune{{0x55, 0x2e, 0x53, 0x2e, 0x41, 0x2e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x69, 0x67, 0x2e}}},
+}
+
+// Run the sentence test cases using the Sentences class.
+func TestSentencesClass(t *testing.T) {
+	allCases := append(sentenceTestCases, sentenceBreakTestCases...)
+	for testNum, testCase := range allCases {
+		s := NewSentences(testCase.original)
+		var index int
+	SentenceLoop:
+		for index = 0; s.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More sentences returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			sentence := s.Runes()
+			if len(sentence) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Sentence at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(sentence),
+					sentence,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range sentence {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Sentence at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						sentence,
+						testCase.expected[index])
+					break SentenceLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer sentences returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Run the sentence test cases using the FirstSentence function for byte slices.
+func TestSentencesFunctionBytes(t *testing.T) {
+	allCases := append(sentenceTestCases, sentenceBreakTestCases...)
+	for testNum, testCase := range allCases {
+		b := []byte(testCase.original)
+		var (
+			state SentenceState
+			index int
+			s     []byte
+		)
+	SentenceLoop:
+		for len(b) > 0 {
+			s, b, state = FirstSentence(b, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More sentences returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			sentence := []rune(string(s))
+			if len(sentence) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Sentence at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(sentence),
+					sentence,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range sentence {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Sentence at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						sentence,
+						testCase.expected[index])
+					break SentenceLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer sentences returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Run the sentence test cases using the FirstSentence function for strings.
+func TestSentencesFunctionString(t *testing.T) {
+	allCases := append(sentenceTestCases, sentenceBreakTestCases...)
+	for testNum, testCase := range allCases {
+		str := testCase.original
+		var (
+			state SentenceState
+			index int
+			s     string
+		)
+	SentenceLoop:
+		for len(str) > 0 {
+			s, str, state = FirstSentenceInString(str, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More sentences returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			sentence := []rune(s)
+			if len(sentence) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Sentence at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(sentence),
+					sentence,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range sentence {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Sentence at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						sentence,
+						testCase.expected[index])
+					break SentenceLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer sentences returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the Positions() and Reset() functions of the Sentences class.
+func TestSentencesPositions(t *testing.T) {
+	s := NewSentences("Hi. You there")
+	if from, to := s.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 0, 0, from, to)
+	}
+	s.Next()
+	s.Next()
+	if from, to := s.Positions(); from != 4 || to != 13 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 4, 13, from, to)
+	}
+	if s.Next() {
+		t.Error("Expected no more sentences")
+	}
+	if from, to := s.Positions(); from != 13 || to != 13 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 13, 13, from, to)
+	}
+	if str := s.Str(); str != "" {
+		t.Errorf(`Expected empty string, got "%s"`, str)
+	}
+	empty := NewSentences("")
+	if empty.Next() {
+		t.Error("Expected no sentences in an empty string")
+	}
+	if from, to := empty.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected from=%d to=%d for an empty string, got from=%d to=%d`, 0, 0, from, to)
+	}
+	s.Reset()
+	s.Next()
+	if str := s.Str(); str != "Hi. " {
+		t.Errorf(`Expected "Hi. ", got "%s"`, str)
+	}
+}
+
+// Benchmark the use of the Sentences class.
+func BenchmarkSentencesClass(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := NewSentences(benchmarkStr)
+		for s.Next() {
+			resultRunes = s.Runes()
+		}
+	}
+}
+
+// Benchmark the use of the FirstSentence function for strings.
+func BenchmarkSentencesFunctionString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var (
+			s     string
+			state SentenceState
+		)
+		str := benchmarkStr
+		for len(str) > 0 {
+			s, str, state = FirstSentenceInString(str, state)
+			resultRunes = []rune(s)
+		}
+	}
+}
+
+// Fuzz the sentence functions, checking that all entry points agree with each
+// other and that the sentences make up the original text.
+func FuzzSentences(f *testing.F) {
+	for _, testCase := range append(sentenceTestCases, sentenceBreakTestCases...) {
+		f.Add(testCase.original)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		var (
+			classSentences []string
+			lastTo         int
+		)
+		s := NewSentences(str)
+		for s.Next() {
+			from, to := s.Positions()
+			if from != lastTo || to <= from {
+				t.Fatalf("%q: Positions() returned %d, %d after %d", str, from, to, lastTo)
+			}
+			classSentences = append(classSentences, str[from:to])
+			lastTo = to
+		}
+		if lastTo != len(str) {
+			t.Fatalf("%q: Sentences end at %d, expected %d", str, lastTo, len(str))
+		}
+
+		var (
+			byteSentences []string
+			sentence      []byte
+			state         SentenceState
+		)
+		for b := []byte(str); len(b) > 0; {
+			sentence, b, state = FirstSentence(b, state)
+			if len(sentence) == 0 {
+				t.Fatalf("%q: FirstSentence() returned an empty segment", str)
+			}
+			byteSentences = append(byteSentences, string(sentence))
+		}
+		if !reflect.DeepEqual(byteSentences, classSentences) {
+			t.Fatalf("%q: FirstSentence() returned %q, Sentences returned %q", str, byteSentences, classSentences)
+		}
+
+		var (
+			stringSentences []string
+			sentenceStr     string
+		)
+		state = SentenceState{}
+		for rest := str; len(rest) > 0; {
+			sentenceStr, rest, state = FirstSentenceInString(rest, state)
+			if len(sentenceStr) == 0 {
+				t.Fatalf("%q: FirstSentenceInString() returned an empty segment", str)
+			}
+			stringSentences = append(stringSentences, sentenceStr)
+		}
+		if !reflect.DeepEqual(stringSentences, classSentences) {
+			t.Fatalf("%q: FirstSentenceInString() returned %q, Sentences returned %q", str, stringSentences, classSentences)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package uniseg
+
+import "unicode/utf8"
+
+// The states of the sentence break parser.
+const (
+	sbAny = iota
+	sbCR
+	sbParaSep
+	sbATerm
+	sbUpper
+	sbLower
+	sbSB7
+	sbSB8Close
+	sbSB8Sp
+	sbSTerm
+	sbSB8aClose
+	sbSB8aSp
+)
+
+// The sentence break parser's breaking instructions.
+const (
+	sbNoBoundary = iota
+	sbBoundary
+)
+
+// The sentence break parser's state transitions. It's analogous to
+// grTransitions, see comments there for details. The "ignore" rule (SB5) and
+// the rule which requires looking ahead more than one code point (SB8) are
+// implemented in transitionSentenceBreakState().
+var sbTransitions = map[[2]int][3]int{
+	// SB3.
+	{sbAny, prCR}: {sbCR, sbNoBoundary, 9990},
+	{sbCR, prLF}:  {sbParaSep, sbNoBoundary, 30},
+
+	// SB4.
+	{sbAny, prSep}:     {sbParaSep, sbNoBoundary, 9990},
+	{sbAny, prLF}:      {sbParaSep, sbNoBoundary, 9990},
+	{sbParaSep, prAny}: {sbAny, sbBoundary, 40},
+	{sbCR, prAny}:      {sbAny, sbBoundary, 40},
+
+	// SB6.
+	{sbAny, prATerm}:     {sbATerm, sbNoBoundary, 9990},
+	{sbATerm, prNumeric}: {sbAny, sbNoBoundary, 60},
+	{sbSB7, prNumeric}:   {sbAny, sbNoBoundary, 60}, // Because ATerm also appears in SB7.
+
+	// SB7.
+	{sbAny, prUpper}:   {sbUpper, sbNoBoundary, 9990},
+	{sbAny, prLower}:   {sbLower, sbNoBoundary, 9990},
+	{sbUpper, prATerm}: {sbSB7, sbNoBoundary, 70},
+	{sbLower, prATerm}: {sbSB7, sbNoBoundary, 70},
+	{sbSB7, prUpper}:   {sbUpper, sbNoBoundary, 70},
+
+	// SB8a.
+	{sbAny, prSTerm}:           {sbSTerm, sbNoBoundary, 9990},
+	{sbATerm, prSContinue}:     {sbAny, sbNoBoundary, 81},
+	{sbATerm, prATerm}:         {sbATerm, sbNoBoundary, 81},
+	{sbATerm, prSTerm}:         {sbSTerm, sbNoBoundary, 81},
+	{sbSB7, prSContinue}:       {sbAny, sbNoBoundary, 81},
+	{sbSB7, prATerm}:           {sbATerm, sbNoBoundary, 81},
+	{sbSB7, prSTerm}:           {sbSTerm, sbNoBoundary, 81},
+	{sbSB8Close, prSContinue}:  {sbAny, sbNoBoundary, 81},
+	{sbSB8Close, prATerm}:      {sbATerm, sbNoBoundary, 81},
+	{sbSB8Close, prSTerm}:      {sbSTerm, sbNoBoundary, 81},
+	{sbSB8Sp, prSContinue}:     {sbAny, sbNoBoundary, 81},
+	{sbSB8Sp, prATerm}:         {sbATerm, sbNoBoundary, 81},
+	{sbSB8Sp, prSTerm}:         {sbSTerm, sbNoBoundary, 81},
+	{sbSTerm, prSContinue}:     {sbAny, sbNoBoundary, 81},
+	{sbSTerm, prATerm}:         {sbATerm, sbNoBoundary, 81},
+	{sbSTerm, prSTerm}:         {sbSTerm, sbNoBoundary, 81},
+	{sbSB8aClose, prSContinue}: {sbAny, sbNoBoundary, 81},
+	{sbSB8aClose, prATerm}:     {sbATerm, sbNoBoundary, 81},
+	{sbSB8aClose, prSTerm}:     {sbSTerm, sbNoBoundary, 81},
+	{sbSB8aSp, prSContinue}:    {sbAny, sbNoBoundary, 81},
+	{sbSB8aSp, prATerm}:        {sbATerm, sbNoBoundary, 81},
+	{sbSB8aSp, prSTerm}:        {sbSTerm, sbNoBoundary, 81},
+
+	// SB9.
+	{sbATerm, prClose}:     {sbSB8Close, sbNoBoundary, 90},
+	{sbSB7, prClose}:       {sbSB8Close, sbNoBoundary, 90},
+	{sbSB8Close, prClose}:  {sbSB8Close, sbNoBoundary, 90},
+	{sbATerm, prSp}:        {sbSB8Sp, sbNoBoundary, 90},
+	{sbSB7, prSp}:          {sbSB8Sp, sbNoBoundary, 90},
+	{sbSB8Close, prSp}:     {sbSB8Sp, sbNoBoundary, 90},
+	{sbSTerm, prClose}:     {sbSB8aClose, sbNoBoundary, 90},
+	{sbSB8aClose, prClose}: {sbSB8aClose, sbNoBoundary, 90},
+	{sbSTerm, prSp}:        {sbSB8aSp, sbNoBoundary, 90},
+	{sbSB8aClose, prSp}:    {sbSB8aSp, sbNoBoundary, 90},
+	{sbATerm, prSep}:       {sbParaSep, sbNoBoundary, 90},
+	{sbATerm, prCR}:        {sbCR, sbNoBoundary, 90},
+	{sbATerm, prLF}:        {sbParaSep, sbNoBoundary, 90},
+	{sbSB7, prSep}:         {sbParaSep, sbNoBoundary, 90},
+	{sbSB7, prCR}:          {sbCR, sbNoBoundary, 90},
+	{sbSB7, prLF}:          {sbParaSep, sbNoBoundary, 90},
+	{sbSB8Close, prSep}:    {sbParaSep, sbNoBoundary, 90},
+	{sbSB8Close, prCR}:     {sbCR, sbNoBoundary, 90},
+	{sbSB8Close, prLF}:     {sbParaSep, sbNoBoundary, 90},
+	{sbSTerm, prSep}:       {sbParaSep, sbNoBoundary, 90},
+	{sbSTerm, prCR}:        {sbCR, sbNoBoundary, 90},
+	{sbSTerm, prLF}:        {sbParaSep, sbNoBoundary, 90},
+	{sbSB8aClose, prSep}:   {sbParaSep, sbNoBoundary, 90},
+	{sbSB8aClose, prCR}:    {sbCR, sbNoBoundary, 90},
+	{sbSB8aClose, prLF}:    {sbParaSep, sbNoBoundary, 90},
+
+	// SB10.
+	{sbSB8Sp, prSp}:   {sbSB8Sp, sbNoBoundary, 100},
+	{sbSB8aSp, prSp}:  {sbSB8aSp, sbNoBoundary, 100},
+	{sbSB8Sp, prSep}:  {sbParaSep, sbNoBoundary, 100},
+	{sbSB8Sp, prCR}:   {sbCR, sbNoBoundary, 100},
+	{sbSB8Sp, prLF}:   {sbParaSep, sbNoBoundary, 100},
+	{sbSB8aSp, prSep}: {sbParaSep, sbNoBoundary, 100},
+	{sbSB8aSp, prCR}:  {sbCR, sbNoBoundary, 100},
+	{sbSB8aSp, prLF}:  {sbParaSep, sbNoBoundary, 100},
+
+	// SB11.
+	{sbATerm, prAny}:     {sbAny, sbBoundary, 110},
+	{sbSB7, prAny}:       {sbAny, sbBoundary, 110},
+	{sbSB8Close, prAny}:  {sbAny, sbBoundary, 110},
+	{sbSB8Sp, prAny}:     {sbAny, sbBoundary, 110},
+	{sbSTerm, prAny}:     {sbAny, sbBoundary, 110},
+	{sbSB8aClose, prAny}: {sbAny, sbBoundary, 110},
+	{sbSB8aSp, prAny}:    {sbAny, sbBoundary, 110},
+	// We'll always break after ParaSep due to SB4.
+}
+
+// transitionSentenceBreakState determines the new state of the sentence break
+// parser given the current state and the next code point. It also returns
+// whether a sentence boundary was detected. If more than one code point is
+// needed to determine the new state, the byte slice or the string starting
+// after rune "r" can be used (whichever is not nil or empty) for further
+// lookups.
+func transitionSentenceBreakState(state int, r rune, b []byte, str string) (newState int, boundary bool) {
+	// Determine the property of the next character.
+	nextProperty := property(sentenceBreakCodePoints, r)
+
+	// SB5 (Replacing Ignore Rules).
+	if nextProperty == prExtend || nextProperty == prFormat {
+		if state == sbParaSep || state == sbCR {
+			return sbAny, true // Make sure we don't apply SB5 to SB3 or SB4.
+		}
+		return state, false
+	}
+
+	// Find the applicable transition.
+	var rule int
+	transition, ok := sbTransitions[[2]int{state, nextProperty}]
+	if ok {
+		// We have a specific transition. We'll use it.
+		newState, boundary, rule = transition[0], transition[1] == sbBoundary, transition[2]
+	} else {
+		// No specific transition found. Try the less specific ones.
+		transAnyProp, okAnyProp := sbTransitions[[2]int{state, prAny}]
+		transAnyState, okAnyState := sbTransitions[[2]int{sbAny, nextProperty}]
+		if okAnyProp && okAnyState {
+			// Both apply. We'll use a mix (see comments for grTransitions).
+			newState, boundary, rule = transAnyState[0], transAnyState[1] == sbBoundary, transAnyState[2]
+			if transAnyProp[2] < transAnyState[2] {
+				boundary, rule = transAnyProp[1] == sbBoundary, transAnyProp[2]
+			}
+		} else if okAnyProp {
+			// We only have a specific state.
+			newState, boundary, rule = transAnyProp[0], transAnyProp[1] == sbBoundary, transAnyProp[2]
+		} else if okAnyState {
+			// We only have a specific property.
+			newState, boundary, rule = transAnyState[0], transAnyState[1] == sbBoundary, transAnyState[2]
+		} else {
+			// No known transition. SB999: Any × Any.
+			newState, boundary, rule = sbAny, false, 9990
+		}
+	}
+
+	// SB8. (SB8a, which would otherwise take precedence, never breaks either.)
+	if rule > 81 && (state == sbATerm || state == sbSB8Close || state == sbSB8Sp || state == sbSB7) {
+		// Check the right side of the rule, skipping over Extend and Format
+		// code points (SB5).
+		if nextSentenceBreakProperty(r, b, str) == prLower {
+			return sbLower, false
+		}
+	}
+
+	return
+}
+
+// nextSentenceBreakProperty scans the code point "r" followed by the byte slice
+// or the string (whichever is not nil or empty) for the first code point that
+// is not excluded by rule SB8, i.e. that is either OLetter, Upper, Lower,
+// ParaSep, or SATerm, and returns its sentence break property. Extend and
+// Format code points are skipped (SB5). It returns -1 if no such code point
+// can be found.
+func nextSentenceBreakProperty(r rune, b []byte, str string) int {
+	for {
+		prop := property(sentenceBreakCodePoints, r)
+		switch prop {
+		case prOLetter, prUpper, prLower, prSep, prCR, prLF, prATerm, prSTerm:
+			return prop
+		}
+		var length int
+		if b != nil { // Byte slice version.
+			r, length = utf8.DecodeRune(b)
+			b = b[length:]
+		} else { // String version.
+			r, length = utf8.DecodeRuneInString(str)
+			str = str[length:]
+		}
+		if length == 0 {
+			return -1 // End of text.
+		}
+	}
+}
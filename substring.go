@@ -0,0 +1,54 @@
+package uniseg
+
+// Substring returns the part of the given string which consists of the
+// grapheme clusters with the indices "from" (inclusive) to "to" (exclusive),
+// i.e. it addresses text by user-perceived characters rather than by bytes or
+// code points. Indices are clamped to the range [0, GraphemeClusterCount(s)]
+// and an empty string is returned if "from" is not smaller than "to".
+//
+// Only the clusters up to index "to" are examined.
+func Substring(s string, from, to int) string {
+	if from < 0 {
+		from = 0
+	}
+	if to <= from {
+		return ""
+	}
+	start := GraphemeClusterOffset(s, from)
+	return s[start : start+GraphemeClusterOffset(s[start:], to-from)]
+}
+
+// GraphemeClusterOffset returns the byte offset of the grapheme cluster with
+// the given index in the string, e.g. 1 for index 1 of "Käse" and 3 for index
+// 2. If the index is negative, 0 is returned. If it is not smaller than the
+// number of grapheme clusters in the string, len(s) is returned.
+//
+// Only the clusters before the given index are examined.
+func GraphemeClusterOffset(s string, index int) int {
+	var state GraphemeState
+	rest := s
+	for ; index > 0 && len(rest) > 0; index-- {
+		_, rest, _, state = FirstGraphemeClusterInString(rest, state)
+	}
+	return len(s) - len(rest)
+}
+
+// GraphemeClusterIndex returns the index of the grapheme cluster which
+// contains the byte at the given offset in the string, e.g. 1 for offsets 1
+// and 2 of "Käse" ("ä" is encoded with two bytes). If the offset is negative,
+// 0 is returned. If it is not smaller than len(s), the number of grapheme
+// clusters in the string is returned.
+//
+// Only the clusters up to the given offset are examined.
+func GraphemeClusterIndex(s string, byteOffset int) (index int) {
+	var state GraphemeState
+	rest := s
+	for len(rest) > 0 {
+		_, rest, _, state = FirstGraphemeClusterInString(rest, state)
+		if len(s)-len(rest) > byteOffset {
+			return
+		}
+		index++
+	}
+	return
+}
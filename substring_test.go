@@ -0,0 +1,87 @@
+package uniseg
+
+import "testing"
+
+// Test the Substring() function.
+func TestSubstring(t *testing.T) {
+	for index, testCase := range []struct {
+		original string
+		from, to int
+		expected string
+	}{
+		{"", 0, 1, ""},
+		{"Käse", 0, 4, "Käse"},
+		{"Käse", 1, 3, "äs"},
+		{"Käse", 3, 10, "e"},
+		{"Käse", -5, 2, "Kä"},
+		{"Käse", 2, 2, ""},
+		{"Käse", 3, 1, ""},
+		{"Käse", 4, 5, ""},
+		{"🇩🇪🏳️‍🌈👍🏼!", 1, 3, "🏳️‍🌈👍🏼"},
+		{"e\u0301e\u0301e\u0301", 1, 2, "e\u0301"},
+	} {
+		if result := Substring(testCase.original, testCase.from, testCase.to); result != testCase.expected {
+			t.Errorf(`Test case %d failed: Substring(%q, %d, %d) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.from,
+				testCase.to,
+				result,
+				testCase.expected)
+		}
+	}
+}
+
+// Test the GraphemeClusterOffset() and GraphemeClusterIndex() functions
+// against the grapheme cluster test cases.
+func TestGraphemeClusterOffsetIndex(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for testNum, testCase := range allCases {
+		var offset int
+		for index, cluster := range testCase.expected {
+			if result := GraphemeClusterOffset(testCase.original, index); result != offset {
+				t.Errorf(`Test case %d "%s" failed: GraphemeClusterOffset(%d) returned %d, expected %d`,
+					testNum,
+					testCase.original,
+					index,
+					result,
+					offset)
+			}
+			length := len(string(cluster))
+			for byteOffset := offset; byteOffset < offset+length; byteOffset++ {
+				if result := GraphemeClusterIndex(testCase.original, byteOffset); result != index {
+					t.Errorf(`Test case %d "%s" failed: GraphemeClusterIndex(%d) returned %d, expected %d`,
+						testNum,
+						testCase.original,
+						byteOffset,
+						result,
+						index)
+				}
+			}
+			offset += length
+		}
+		count := len(testCase.expected)
+		if result := GraphemeClusterOffset(testCase.original, count); result != len(testCase.original) {
+			t.Errorf(`Test case %d "%s" failed: GraphemeClusterOffset(%d) returned %d, expected %d`,
+				testNum,
+				testCase.original,
+				count,
+				result,
+				len(testCase.original))
+		}
+		if result := GraphemeClusterIndex(testCase.original, len(testCase.original)); result != count {
+			t.Errorf(`Test case %d "%s" failed: GraphemeClusterIndex(%d) returned %d, expected %d`,
+				testNum,
+				testCase.original,
+				len(testCase.original),
+				result,
+				count)
+		}
+		if result := GraphemeClusterOffset(testCase.original, -1); result != 0 {
+			t.Errorf(`Test case %d "%s" failed: GraphemeClusterOffset(-1) returned %d`, testNum, testCase.original, result)
+		}
+		if result := GraphemeClusterIndex(testCase.original, -1); result != 0 {
+			t.Errorf(`Test case %d "%s" failed: GraphemeClusterIndex(-1) returned %d`, testNum, testCase.original, result)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package uniseg
+
+// Truncate shortens the given string such that its monospace width (see
+// StringWidth()) does not exceed "maxWidth". If the string needs to be
+// shortened, its end is cut off and replaced with "tail" (e.g. "…" or "..."),
+// with the width of the tail included in "maxWidth". Grapheme clusters are
+// never split. If "tail" itself is wider than "maxWidth", it is truncated
+// without a tail. The string is returned unchanged if it fits.
+func Truncate(s string, maxWidth int, tail string) string {
+	if StringWidth(s) <= maxWidth {
+		return s
+	}
+	budget, tail := truncateTail(tail, maxWidth)
+	head, _ := widthPrefix(s, budget)
+	return head + tail
+}
+
+// TruncateMiddle is like Truncate() but keeps the beginning and the end of the
+// string and replaces the middle with "tail". This is common when displaying
+// long file paths or URLs. The available width is split evenly between the two
+// ends, with the beginning receiving the extra cell if the width is odd.
+func TruncateMiddle(s string, maxWidth int, tail string) string {
+	if StringWidth(s) <= maxWidth {
+		return s
+	}
+	budget, tail := truncateTail(tail, maxWidth)
+	head, headWidth := widthPrefix(s, (budget+1)/2)
+	end, endWidth := widthSuffix(s[len(head):], budget-headWidth)
+	if endWidth < budget-headWidth {
+		// A wide cluster at the end didn't fit. Give the space to the beginning.
+		head, _ = widthPrefix(s[:len(s)-len(end)], budget-endWidth)
+	}
+	return head + tail + end
+}
+
+// TruncateStart is like Truncate() but cuts off the beginning of the string,
+// keeping its end, and prepends "tail".
+func TruncateStart(s string, maxWidth int, tail string) string {
+	if StringWidth(s) <= maxWidth {
+		return s
+	}
+	budget, tail := truncateTail(tail, maxWidth)
+	end, _ := widthSuffix(s, budget)
+	return tail + end
+}
+
+// truncateTail returns the width available for the truncated string when the
+// given tail is added, and the tail itself, which may be shortened (and the
+// available width be 0) if it doesn't fit into "maxWidth".
+func truncateTail(tail string, maxWidth int) (budget int, newTail string) {
+	if maxWidth <= 0 {
+		return 0, ""
+	}
+	width := StringWidth(tail)
+	if width > maxWidth {
+		tail, _ = widthPrefix(tail, maxWidth)
+		return 0, tail
+	}
+	return maxWidth - width, tail
+}
+
+// widthPrefix returns the longest prefix of the string, consisting of entire
+// grapheme clusters, whose monospace width does not exceed "maxWidth", along
+// with that width.
+func widthPrefix(s string, maxWidth int) (prefix string, width int) {
+	var (
+		cluster string
+		rest    = s
+		state   GraphemeState
+	)
+	for len(rest) > 0 {
+		cluster, rest, _, state = FirstGraphemeClusterInString(rest, state)
+		clusterWidth := clusterWidthInString(cluster)
+		if width+clusterWidth > maxWidth {
+			return s[:len(s)-len(rest)-len(cluster)], width
+		}
+		width += clusterWidth
+	}
+	return s, width
+}
+
+// widthSuffix returns the longest suffix of the string, consisting of entire
+// grapheme clusters, whose monospace width does not exceed "maxWidth", along
+// with that width.
+func widthSuffix(s string, maxWidth int) (suffix string, width int) {
+	var cluster string
+	rest := s
+	for len(rest) > 0 {
+		cluster, rest = LastGraphemeClusterInString(rest)
+		clusterWidth := clusterWidthInString(cluster)
+		if width+clusterWidth > maxWidth {
+			return s[len(rest)+len(cluster):], width
+		}
+		width += clusterWidth
+	}
+	return s, width
+}
@@ -0,0 +1,102 @@
+package uniseg
+
+import "testing"
+
+// Test cases for the truncation functions.
+var truncateTestCases = []struct {
+	original string
+	maxWidth int
+	tail     string
+	end      string // Expected result of Truncate().
+	middle   string // Expected result of TruncateMiddle().
+	start    string // Expected result of TruncateStart().
+}{
+	{"", 5, "…", "", "", ""},
+	{"Hello", 5, "…", "Hello", "Hello", "Hello"},
+	{"Hello, world", 0, "…", "", "", ""},
+	{"Hello, world", -1, "…", "", "", ""},
+	{"Hello, world", 1, "…", "…", "…", "…"},
+	{"Hello, world", 8, "…", "Hello, …", "Hell…rld", "…, world"},
+	{"Hello, world", 8, "...", "Hello...", "Hel...ld", "...world"},
+	{"Hello, world", 2, "...", "..", "..", ".."},
+	{"Hello, world", 8, "", "Hello, w", "Hellorld", "o, world"},
+	{"世界世界世界", 5, "…", "世界…", "世…界", "…世界"},
+	{"世界世界世界", 6, "…", "世界…", "世…界", "…世界"},
+	{"a世界世界b", 4, "…", "a世…", "a…b", "…界b"},
+	{"a世界世界b", 5, "…", "a世…", "a…界b", "…界b"},
+	{"e\u0301e\u0301e\u0301e\u0301", 3, "…", "e\u0301e\u0301…", "e\u0301…e\u0301", "…e\u0301e\u0301"},
+	{"👍🏼👍🏼👍🏼", 5, "…", "👍🏼👍🏼…", "👍🏼…👍🏼", "…👍🏼👍🏼"},
+	{"🇩🇪🇩🇪🇩🇪", 4, "…", "🇩🇪…", "🇩🇪…", "…🇩🇪"},
+	{"/usr/local/share/doc", 12, "…", "/usr/local/…", "/usr/l…e/doc", "…l/share/doc"},
+}
+
+// Test the Truncate() function.
+func TestTruncate(t *testing.T) {
+	for index, testCase := range truncateTestCases {
+		if result := Truncate(testCase.original, testCase.maxWidth, testCase.tail); result != testCase.end {
+			t.Errorf(`Test case %d failed: Truncate(%q, %d, %q) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.maxWidth,
+				testCase.tail,
+				result,
+				testCase.end)
+		}
+	}
+}
+
+// Test the TruncateMiddle() function.
+func TestTruncateMiddle(t *testing.T) {
+	for index, testCase := range truncateTestCases {
+		if result := TruncateMiddle(testCase.original, testCase.maxWidth, testCase.tail); result != testCase.middle {
+			t.Errorf(`Test case %d failed: TruncateMiddle(%q, %d, %q) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.maxWidth,
+				testCase.tail,
+				result,
+				testCase.middle)
+		}
+	}
+}
+
+// Test the TruncateStart() function.
+func TestTruncateStart(t *testing.T) {
+	for index, testCase := range truncateTestCases {
+		if result := TruncateStart(testCase.original, testCase.maxWidth, testCase.tail); result != testCase.start {
+			t.Errorf(`Test case %d failed: TruncateStart(%q, %d, %q) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.maxWidth,
+				testCase.tail,
+				result,
+				testCase.start)
+		}
+	}
+}
+
+// Test that truncated strings never exceed the maximum width.
+func TestTruncateWidth(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for index, testCase := range allCases {
+		width := StringWidth(testCase.original)
+		for maxWidth := 0; maxWidth <= width; maxWidth++ {
+			for name, truncate := range map[string]func(string, int, string) string{
+				"Truncate":       Truncate,
+				"TruncateMiddle": TruncateMiddle,
+				"TruncateStart":  TruncateStart,
+			} {
+				result := truncate(testCase.original, maxWidth, "…")
+				if resultWidth := StringWidth(result); resultWidth > maxWidth {
+					t.Errorf(`Test case %d failed: %s(%q, %d) returned %q with width %d`,
+						index,
+						name,
+						testCase.original,
+						maxWidth,
+						result,
+						resultWidth)
+				}
+			}
+		}
+	}
+}
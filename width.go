@@ -0,0 +1,125 @@
+package uniseg
+
+import "unicode/utf8"
+
+// Variation selectors which request text or emoji presentation for the
+// preceding character.
+const (
+	vs15 = 0xfe0e // Text presentation.
+	vs16 = 0xfe0f // Emoji presentation.
+)
+
+// runeWidth returns the monospace width for the given rune. The provided
+// grapheme property is a value returned by graphemeProperty().
+//
+// Every rune has a width of 1, except for runes with the following properties
+// (evaluated in this order):
+//
+//   - Control, CR, LF, Extend, ZWJ: Width of 0
+//   - U+2E3A TWO-EM DASH: Width of 3
+//   - U+2E3B THREE-EM DASH: Width of 4
+//   - East-Asian width Fullwidth and Wide: Width of 2 (Ambiguous and Neutral
+//     have a width of 1)
+//   - Regional Indicator: Width of 2
+//   - Extended Pictographic: Width of 2, unless Emoji Presentation is "No".
+func runeWidth(r rune, graphemeProperty int) int {
+	switch graphemeProperty {
+	case prControl, prCR, prLF, prExtend, prZWJ:
+		return 0
+	case prRegionalIndicator:
+		return 2
+	case prExtendedPictographic:
+		if property(emojiPresentation, r) == prEmojiPresentation {
+			return 2
+		}
+		return 1
+	}
+
+	switch r {
+	case 0x2e3a:
+		return 3
+	case 0x2e3b:
+		return 4
+	}
+
+	switch property(eastAsianWidth, r) {
+	case prW, prF:
+		return 2
+	}
+
+	return 1
+}
+
+// addClusterWidth returns the width of a grapheme cluster after the code point
+// "r" (with grapheme property "prop") was appended to it. "width" is the width
+// of the cluster so far and "firstProperty" is the grapheme property of the
+// cluster's first code point. The width of a cluster is mostly determined by
+// its first code point:
+//
+//   - Emoji presentation selector (VS16): The cluster has a width of 2.
+//   - Extended Pictographic: A text presentation selector (VS15) reduces the
+//     width to 1, anything else (e.g. emoji modifiers, ZWJ sequences) makes it
+//     2.
+//   - Regional Indicator pairs and Hangul syllables: The first code point
+//     determines the width.
+//   - Everything else: The widths of all code points are added.
+func addClusterWidth(width, firstProperty int, r rune, prop int) int {
+	if r == vs16 {
+		return 2
+	}
+	switch firstProperty {
+	case prExtendedPictographic:
+		if r == vs15 {
+			return 1
+		}
+		return 2
+	case prRegionalIndicator, prL, prLV, prLVT:
+		return width
+	}
+	return width + runeWidth(r, prop)
+}
+
+// clusterWidth returns the monospace width of the given grapheme cluster.
+func clusterWidth(cluster []byte) (width int) {
+	r, length := utf8.DecodeRune(cluster)
+	if length == 0 {
+		return 0
+	}
+	firstProperty := graphemeProperty(r)
+	width = runeWidth(r, firstProperty)
+	for cluster = cluster[length:]; len(cluster) > 0; cluster = cluster[length:] {
+		r, length = utf8.DecodeRune(cluster)
+		width = addClusterWidth(width, firstProperty, r, graphemeProperty(r))
+	}
+	return
+}
+
+// clusterWidthInString is like clusterWidth() but its input is a string.
+func clusterWidthInString(cluster string) (width int) {
+	r, length := utf8.DecodeRuneInString(cluster)
+	if length == 0 {
+		return 0
+	}
+	firstProperty := graphemeProperty(r)
+	width = runeWidth(r, firstProperty)
+	for _, r := range cluster[length:] {
+		width = addClusterWidth(width, firstProperty, r, graphemeProperty(r))
+	}
+	return
+}
+
+// StringWidth returns the monospace width for the given string, that is, the
+// number of same-size cells to be occupied by the string. The width is
+// determined for each grapheme cluster individually (see Graphemes.Width())
+// and then added up.
+func StringWidth(s string) (width int) {
+	var (
+		cluster string
+		state   GraphemeState
+	)
+	for len(s) > 0 {
+		cluster, s, _, state = FirstGraphemeClusterInString(s, state)
+		width += clusterWidthInString(cluster)
+	}
+	return
+}
@@ -0,0 +1,85 @@
+package uniseg
+
+import "testing"
+
+// The test cases for the width functions.
+var widthTestCases = []struct {
+	original string
+	width    int
+}{
+	{original: "", width: 0},
+	{original: "Hello, world!", width: 13},
+	{original: "\x00", width: 0},
+	{original: "\r\n", width: 0},
+	{original: "\u200b", width: 0},
+	{original: "e\u0301", width: 1},
+	{original: "日本語", width: 6},
+	{original: "\uff71", width: 1},
+	{original: "\uff21", width: 2},
+	{original: "\u2e3a", width: 3},
+	{original: "\u2e3b", width: 4},
+	{original: "\u1100\u1161\u11a8", width: 2},
+	{original: "\uac00\u11a8", width: 2},
+	{original: "🇩🇪", width: 2},
+	{original: "🇩🇪🇫🇷", width: 4},
+	{original: "🇩🇪🇫", width: 4},
+	{original: "👍", width: 2},
+	{original: "👍🏼", width: 2},
+	{original: "\U0001f469\u200d\U0001f469\u200d\U0001f467", width: 2},
+	{original: "\u263a", width: 1},
+	{original: "\u263a\ufe0f", width: 2},
+	{original: "\u231a", width: 2},
+	{original: "\u231a\ufe0e", width: 1},
+	{original: "#\ufe0f\u20e3", width: 2},
+	{original: "a\U0001f469\u200d\U0001f469\u200d\U0001f467b", width: 4},
+}
+
+// Run the width test cases using StringWidth().
+func TestWidthString(t *testing.T) {
+	for testNum, testCase := range widthTestCases {
+		if width := StringWidth(testCase.original); width != testCase.width {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d`,
+				testNum,
+				testCase.original,
+				testCase.width,
+				width)
+		}
+	}
+}
+
+// Run the width test cases using the Graphemes class.
+func TestWidthGraphemes(t *testing.T) {
+	for testNum, testCase := range widthTestCases {
+		var width int
+		g := NewGraphemes(testCase.original)
+		if w := g.Width(); w != 0 {
+			t.Errorf(`Test case %d %q failed: Expected width 0 before Next(), got %d`,
+				testNum,
+				testCase.original,
+				w)
+		}
+		for g.Next() {
+			width += g.Width()
+		}
+		if width != testCase.width {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d`,
+				testNum,
+				testCase.original,
+				testCase.width,
+				width)
+		}
+		if w := g.Width(); w != 0 {
+			t.Errorf(`Test case %d %q failed: Expected width 0 after the last cluster, got %d`,
+				testNum,
+				testCase.original,
+				w)
+		}
+	}
+}
+
+// Benchmark the use of StringWidth().
+func BenchmarkStringWidth(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		resultWidth = StringWidth(benchmarkStr)
+	}
+}
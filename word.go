@@ -0,0 +1,201 @@
+package uniseg
+
+import "unicode/utf8"
+
+// Words implements an iterator over words, as specified in the Unicode
+// Standard Annex #29, Word Boundaries. Words are delimited by boundaries such
+// as spaces and punctuation, with each space and punctuation character (or
+// sequence of them, e.g. multiple spaces) being returned as a "word" of its
+// own. Keeping in mind that some languages don't use spaces to separate words,
+// a simple check with unicode.IsLetter() on the first code point of a word may
+// help to exclude spaces and punctuation.
+type Words struct {
+	// The original string.
+	original string
+
+	// The part of the original string which has not been parsed yet.
+	remaining string
+
+	// The byte positions of the current word in the original string. If
+	// start == end, we either haven't started iterating yet (0) or the
+	// iteration has already completed (len(original)).
+	start, end int
+
+	// The current state of the word break parser.
+	state WordState
+}
+
+// NewWords returns a new word iterator.
+func NewWords(s string) *Words {
+	return &Words{
+		original:  s,
+		remaining: s,
+	}
+}
+
+// Next advances the iterator by one word and returns false if no words are
+// left. This function must be called before the first word is accessed.
+func (w *Words) Next() bool {
+	if len(w.remaining) == 0 {
+		// We're already past the end.
+		w.start, w.end = len(w.original), len(w.original)
+		return false
+	}
+	var word string
+	word, w.remaining, w.state = FirstWordInString(w.remaining, w.state)
+	w.start = len(w.original) - len(w.remaining) - len(word)
+	w.end = w.start + len(word)
+	return true
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// word. If the iterator is already past the end or Next() has not yet been
+// called, nil is returned.
+func (w *Words) Runes() []rune {
+	if w.start == w.end {
+		return nil
+	}
+	return []rune(w.original[w.start:w.end])
+}
+
+// Str returns a substring of the original string which corresponds to the
+// current word. If the iterator is already past the end or Next() has not yet
+// been called, an empty string is returned.
+func (w *Words) Str() string {
+	if w.start == w.end {
+		return ""
+	}
+	return w.original[w.start:w.end]
+}
+
+// Bytes returns a byte slice which corresponds to the current word. If the
+// iterator is already past the end or Next() has not yet been called, nil is
+// returned.
+func (w *Words) Bytes() []byte {
+	if w.start == w.end {
+		return nil
+	}
+	return []byte(w.original[w.start:w.end])
+}
+
+// Positions returns the interval of the current word as byte positions into
+// the original string. The first returned value "from" indexes the first byte
+// and the second returned value "to" indexes the first byte that is not
+// included anymore, i.e. str[from:to] is the current word of the original
+// string "str". If Next() has not yet been called, both values are 0. If the
+// iterator is already past the end, both values are len(str).
+func (w *Words) Positions() (int, int) {
+	return w.start, w.end
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// Next() sets it to the first word again.
+func (w *Words) Reset() {
+	w.remaining = w.original
+	w.start, w.end = 0, 0
+	w.state = WordState{}
+}
+
+// WordState holds the state of the word break parser between calls to
+// FirstWord() or FirstWordInString(). Its zero value represents an unknown
+// state, which is what must be passed when parsing starts. Its fields are
+// internal and may change in future versions.
+type WordState struct {
+	// The state of the word break parser after the first code point of the
+	// next word has been processed.
+	state int
+
+	// Whether or not "state" contains a valid parser state.
+	valid bool
+}
+
+// FirstWord returns the first word (as a slice of bytes) found in the given
+// byte slice according to the rules of Unicode Standard Annex #29, Word
+// Boundaries. This function can be called continuously to extract all words
+// from a byte slice, as follows:
+//
+//   var state uniseg.WordState
+//   for len(b) > 0 {
+//       w, b, state = uniseg.FirstWord(b, state)
+//       // Do something with w.
+//   }
+//
+// If you don't know the current state, for example when calling the function
+// for the first time, you must pass the zero value of WordState. For
+// consecutive calls, you should pass the state returned by the previous call.
+//
+// The "rest" slice is the subslice of the original byte slice "b" starting
+// after the last byte of the identified word. If the length of the "rest" slice
+// is 0, the entire byte slice "b" has been processed.
+//
+// For an empty byte slice "b", the function returns nil values.
+func FirstWord(b []byte, state WordState) (word, rest []byte, newState WordState) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRune(b)
+	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
+		return b, nil, WordState{}
+	}
+
+	// If we don't know the state, determine it now.
+	s := state.state
+	if !state.valid {
+		s, _ = transitionWordBreakState(wbAny, r, b[length:], "")
+	}
+
+	// Transition until we find a boundary.
+	var boundary bool
+	for {
+		r, l := utf8.DecodeRune(b[length:])
+		s, boundary = transitionWordBreakState(s, r, b[length+l:], "")
+
+		if boundary {
+			return b[:length], b[length:], WordState{state: s, valid: true}
+		}
+
+		length += l
+		if len(b) <= length {
+			return b, nil, WordState{}
+		}
+	}
+}
+
+// FirstWordInString is like FirstWord() but its input and outputs are strings.
+func FirstWordInString(str string, state WordState) (word, rest string, newState WordState) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
+	}
+
+	// Extract the first rune.
+	r, length := utf8.DecodeRuneInString(str)
+	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
+		return str, "", WordState{}
+	}
+
+	// If we don't know the state, determine it now.
+	s := state.state
+	if !state.valid {
+		s, _ = transitionWordBreakState(wbAny, r, nil, str[length:])
+	}
+
+	// Transition until we find a boundary.
+	var boundary bool
+	for {
+		r, l := utf8.DecodeRuneInString(str[length:])
+		s, boundary = transitionWordBreakState(s, r, nil, str[length+l:])
+
+		if boundary {
+			return str[:length], str[length:], WordState{state: s, valid: true}
+		}
+
+		length += l
+		if len(str) <= length {
+			return str, "", WordState{}
+		}
+	}
+}
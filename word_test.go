@@ -0,0 +1,310 @@
+package uniseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+// The test cases for the word functions.
+var wordTestCases = []testCase{
+	{original: "", expected: [][]rune{}},
+	{original: "x", expected: [][]rune{{0x78}}},
+	{original: "Hello, world!", expected: [][]rune{{0x48, 0x65, 0x6c, 0x6c, 0x6f}, {0x2c}, {0x20}, {0x77, 0x6f, 0x72, 0x6c, 0x64}, {0x21}}},
+	{original: "can't stop", expected: [][]rune{{0x63, 0x61, 0x6e, 0x27, 0x74}, {0x20}, {0x73, 0x74, 0x6f, 0x70}}},
+	{original: "'quoted'", expected: [][]rune{{0x27}, {0x71, 0x75, 0x6f, 0x74, 0x65, 0x64}, {0x27}}},
+	{original: "3.14", expected: [][]rune{{0x33, 0x2e, 0x31, 0x34}}},
+	{original: "1,000.5", expected: [][]rune{{0x31, 0x2c, 0x30, 0x30, 0x30, 0x2e, 0x35}}},
+	{original: "v2.0.", expected: [][]rune{{0x76, 0x32, 0x2e, 0x30}, {0x2e}}},
+	{original: "snake_case", expected: [][]rune{{0x73, 0x6e, 0x61, 0x6b, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65}}},
+	{original: "a  b", expected: [][]rune{{0x61}, {0x20, 0x20}, {0x62}}},
+	{original: "\r\n\r\n", expected: [][]rune{{0xd, 0xa}, {0xd, 0xa}}},
+	{original: "Ka\u0308se", expected: [][]rune{{0x4b, 0x61, 0x308, 0x73, 0x65}}},
+	{original: "カタカナ語", expected: [][]rune{{0x30ab, 0x30bf, 0x30ab, 0x30ca}, {0x8a9e}}},
+	{original: "👩‍❤️‍💋‍👩!", expected: [][]rune{{0x1f469, 0x200d, 0x2764, 0xfe0f, 0x200d, 0x1f48b, 0x200d, 0x1f469}, {0x21}}},
+	{original: "🇩🇪🇫🇷🇮", expected: [][]rune{{0x1f1e9, 0x1f1ea}, {0x1f1eb, 0x1f1f7}, {0x1f1ee}}},
+	{original: "א\"ב", expected: [][]rune{{0x5d0, 0x22, 0x5d1}}},
+}
+
+// Run the word test cases using the Words class.
+func TestWordsClass(t *testing.T) {
+	allCases := append(wordTestCases, wordBreakTestCases...)
+	for testNum, testCase := range allCases {
+		w := NewWords(testCase.original)
+		var index int
+	WordLoop:
+		for index = 0; w.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More words returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			word := w.Runes()
+			if len(word) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Word at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(word),
+					word,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range word {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Word at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						word,
+						testCase.expected[index])
+					break WordLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer words returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Run the word test cases using the FirstWord function for byte slices.
+func TestWordsFunctionBytes(t *testing.T) {
+	allCases := append(wordTestCases, wordBreakTestCases...)
+	for testNum, testCase := range allCases {
+		b := []byte(testCase.original)
+		var (
+			state WordState
+			index int
+			w     []byte
+		)
+	WordLoop:
+		for len(b) > 0 {
+			w, b, state = FirstWord(b, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More words returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			word := []rune(string(w))
+			if len(word) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Word at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(word),
+					word,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range word {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Word at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						word,
+						testCase.expected[index])
+					break WordLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer words returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Run the word test cases using the FirstWord function for strings.
+func TestWordsFunctionString(t *testing.T) {
+	allCases := append(wordTestCases, wordBreakTestCases...)
+	for testNum, testCase := range allCases {
+		str := testCase.original
+		var (
+			state WordState
+			index int
+			w     string
+		)
+	WordLoop:
+		for len(str) > 0 {
+			w, str, state = FirstWordInString(str, state)
+
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d "%s" failed: More words returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+
+			word := []rune(w)
+			if len(word) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d "%s" failed: Word at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(word),
+					word,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range word {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d "%s" failed: Word at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						word,
+						testCase.expected[index])
+					break WordLoop
+				}
+			}
+
+			index++
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d "%s" failed: Fewer words returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the Positions() and Reset() functions of the Words class.
+func TestWordsPositions(t *testing.T) {
+	w := NewWords("Hi there")
+	if from, to := w.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 0, 0, from, to)
+	}
+	w.Next()
+	w.Next()
+	w.Next()
+	if from, to := w.Positions(); from != 3 || to != 8 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 3, 8, from, to)
+	}
+	if w.Next() {
+		t.Error("Expected no more words")
+	}
+	if from, to := w.Positions(); from != 8 || to != 8 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 8, 8, from, to)
+	}
+	if str := w.Str(); str != "" {
+		t.Errorf(`Expected empty string, got "%s"`, str)
+	}
+	empty := NewWords("")
+	if empty.Next() {
+		t.Error("Expected no words in an empty string")
+	}
+	if from, to := empty.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected from=%d to=%d for an empty string, got from=%d to=%d`, 0, 0, from, to)
+	}
+	w.Reset()
+	w.Next()
+	if str := w.Str(); str != "Hi" {
+		t.Errorf(`Expected "Hi", got "%s"`, str)
+	}
+}
+
+// Benchmark the use of the Words class.
+func BenchmarkWordsClass(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		w := NewWords(benchmarkStr)
+		for w.Next() {
+			resultRunes = w.Runes()
+		}
+	}
+}
+
+// Benchmark the use of the FirstWord function for strings.
+func BenchmarkWordsFunctionString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var (
+			w     string
+			state WordState
+		)
+		str := benchmarkStr
+		for len(str) > 0 {
+			w, str, state = FirstWordInString(str, state)
+			resultRunes = []rune(w)
+		}
+	}
+}
+
+// Fuzz the word functions, checking that all entry points agree with each
+// other and that the words make up the original text.
+func FuzzWords(f *testing.F) {
+	for _, testCase := range append(wordTestCases, wordBreakTestCases...) {
+		f.Add(testCase.original)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		var (
+			classWords []string
+			lastTo     int
+		)
+		w := NewWords(str)
+		for w.Next() {
+			from, to := w.Positions()
+			if from != lastTo || to <= from {
+				t.Fatalf("%q: Positions() returned %d, %d after %d", str, from, to, lastTo)
+			}
+			classWords = append(classWords, str[from:to])
+			lastTo = to
+		}
+		if lastTo != len(str) {
+			t.Fatalf("%q: Words end at %d, expected %d", str, lastTo, len(str))
+		}
+
+		var (
+			byteWords []string
+			word      []byte
+			state     WordState
+		)
+		for b := []byte(str); len(b) > 0; {
+			word, b, state = FirstWord(b, state)
+			if len(word) == 0 {
+				t.Fatalf("%q: FirstWord() returned an empty segment", str)
+			}
+			byteWords = append(byteWords, string(word))
+		}
+		if !reflect.DeepEqual(byteWords, classWords) {
+			t.Fatalf("%q: FirstWord() returned %q, Words returned %q", str, byteWords, classWords)
+		}
+
+		var (
+			stringWords []string
+			s           string
+		)
+		state = WordState{}
+		for rest := str; len(rest) > 0; {
+			s, rest, state = FirstWordInString(rest, state)
+			if len(s) == 0 {
+				t.Fatalf("%q: FirstWordInString() returned an empty segment", str)
+			}
+			stringWords = append(stringWords, s)
+		}
+		if !reflect.DeepEqual(stringWords, classWords) {
+			t.Fatalf("%q: FirstWordInString() returned %q, Words returned %q", str, stringWords, classWords)
+		}
+	})
+}
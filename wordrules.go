@@ -0,0 +1,232 @@
+package uniseg
+
+import "unicode/utf8"
+
+// The states of the word break parser.
+const (
+	wbAny = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbWSegSpace
+	wbHebrewLetter
+	wbALetter
+	wbWB7
+	wbWB7c
+	wbNumeric
+	wbWB11
+	wbKatakana
+	wbExtendNumLet
+	wbOddRI
+	wbEvenRI
+	wbZWJBit = 16 // This bit is set for any states followed by at least one zero-width joiner (see WB4 and WB3c).
+)
+
+// The word break parser's breaking instructions.
+const (
+	wbNoBoundary = iota
+	wbBoundary
+)
+
+// The word break parser's state transitions. It's analogous to grTransitions,
+// see comments there for details. Transitions which require looking ahead
+// more than one code point (WB6, WB7b, WB12) as well as the handling of
+// regional indicators (WB15, WB16) and the "ignore" rules (WB4) are
+// implemented in transitionWordBreakState().
+var wbTransitions = map[[2]int][3]int{
+	// WB3b.
+	{wbAny, prNewline}: {wbNewline, wbBoundary, 32},
+	{wbAny, prCR}:      {wbCR, wbBoundary, 32},
+	{wbAny, prLF}:      {wbLF, wbBoundary, 32},
+
+	// WB3a.
+	{wbNewline, prAny}: {wbAny, wbBoundary, 31},
+	{wbCR, prAny}:      {wbAny, wbBoundary, 31},
+	{wbLF, prAny}:      {wbAny, wbBoundary, 31},
+
+	// WB3.
+	{wbCR, prLF}: {wbLF, wbNoBoundary, 30},
+
+	// WB3d.
+	{wbAny, prWSegSpace}:       {wbWSegSpace, wbBoundary, 9990},
+	{wbWSegSpace, prWSegSpace}: {wbWSegSpace, wbNoBoundary, 34},
+
+	// WB5.
+	{wbAny, prALetter}:               {wbALetter, wbBoundary, 9990},
+	{wbAny, prHebrewLetter}:          {wbHebrewLetter, wbBoundary, 9990},
+	{wbALetter, prALetter}:           {wbALetter, wbNoBoundary, 50},
+	{wbALetter, prHebrewLetter}:      {wbHebrewLetter, wbNoBoundary, 50},
+	{wbHebrewLetter, prALetter}:      {wbALetter, wbNoBoundary, 50},
+	{wbHebrewLetter, prHebrewLetter}: {wbHebrewLetter, wbNoBoundary, 50},
+
+	// WB7. Transitions to wbWB7 are handled by transitionWordBreakState().
+	{wbWB7, prALetter}:      {wbALetter, wbNoBoundary, 70},
+	{wbWB7, prHebrewLetter}: {wbHebrewLetter, wbNoBoundary, 70},
+
+	// WB7a.
+	{wbHebrewLetter, prSingleQuote}: {wbAny, wbNoBoundary, 71},
+
+	// WB7c. Transitions to wbWB7c are handled by transitionWordBreakState().
+	{wbWB7c, prHebrewLetter}: {wbHebrewLetter, wbNoBoundary, 73},
+
+	// WB8.
+	{wbAny, prNumeric}:     {wbNumeric, wbBoundary, 9990},
+	{wbNumeric, prNumeric}: {wbNumeric, wbNoBoundary, 80},
+
+	// WB9.
+	{wbALetter, prNumeric}:      {wbNumeric, wbNoBoundary, 90},
+	{wbHebrewLetter, prNumeric}: {wbNumeric, wbNoBoundary, 90},
+
+	// WB10.
+	{wbNumeric, prALetter}:      {wbALetter, wbNoBoundary, 100},
+	{wbNumeric, prHebrewLetter}: {wbHebrewLetter, wbNoBoundary, 100},
+
+	// WB11. Transitions to wbWB11 are handled by transitionWordBreakState().
+	{wbWB11, prNumeric}: {wbNumeric, wbNoBoundary, 110},
+
+	// WB13.
+	{wbAny, prKatakana}:      {wbKatakana, wbBoundary, 9990},
+	{wbKatakana, prKatakana}: {wbKatakana, wbNoBoundary, 130},
+
+	// WB13a.
+	{wbAny, prExtendNumLet}:          {wbExtendNumLet, wbBoundary, 9990},
+	{wbALetter, prExtendNumLet}:      {wbExtendNumLet, wbNoBoundary, 131},
+	{wbHebrewLetter, prExtendNumLet}: {wbExtendNumLet, wbNoBoundary, 131},
+	{wbNumeric, prExtendNumLet}:      {wbExtendNumLet, wbNoBoundary, 131},
+	{wbKatakana, prExtendNumLet}:     {wbExtendNumLet, wbNoBoundary, 131},
+	{wbExtendNumLet, prExtendNumLet}: {wbExtendNumLet, wbNoBoundary, 131},
+
+	// WB13b.
+	{wbExtendNumLet, prALetter}:      {wbALetter, wbNoBoundary, 132},
+	{wbExtendNumLet, prHebrewLetter}: {wbHebrewLetter, wbNoBoundary, 132},
+	{wbExtendNumLet, prNumeric}:      {wbNumeric, wbNoBoundary, 132},
+	{wbExtendNumLet, prKatakana}:     {wbKatakana, wbNoBoundary, 132},
+}
+
+// transitionWordBreakState determines the new state of the word break parser
+// given the current state and the next code point. It also returns whether a
+// word boundary was detected. If more than one code point is needed to
+// determine the new state, the byte slice or the string starting after rune "r"
+// can be used (whichever is not nil or empty) for further lookups.
+func transitionWordBreakState(state int, r rune, b []byte, str string) (newState int, boundary bool) {
+	// Determine the property of the next character.
+	nextProperty := property(wordBreakCodePoints, r)
+
+	// "Replacing Ignore Rules".
+	if nextProperty == prExtend || nextProperty == prFormat || nextProperty == prZWJ {
+		// WB4. Zero-width joiners are remembered for WB3c.
+		var zwj int
+		if nextProperty == prZWJ {
+			zwj = wbZWJBit
+		}
+		switch state &^ wbZWJBit {
+		case wbNewline, wbCR, wbLF:
+			// Don't apply WB4 to WB3a.
+			return wbAny | zwj, true
+		case wbWSegSpace:
+			// WB3d does not apply anymore.
+			return wbAny | zwj, false
+		}
+		return state&^wbZWJBit | zwj, false
+	}
+	if nextProperty == prExtendedPictographic && state&wbZWJBit != 0 {
+		// WB3c.
+		return wbAny, false
+	}
+	state &^= wbZWJBit
+
+	// Find the applicable transition.
+	var rule int
+	transition, ok := wbTransitions[[2]int{state, nextProperty}]
+	if ok {
+		// We have a specific transition. We'll use it.
+		newState, boundary, rule = transition[0], transition[1] == wbBoundary, transition[2]
+	} else {
+		// No specific transition found. Try the less specific ones.
+		transAnyProp, okAnyProp := wbTransitions[[2]int{state, prAny}]
+		transAnyState, okAnyState := wbTransitions[[2]int{wbAny, nextProperty}]
+		if okAnyProp && okAnyState {
+			// Both apply. We'll use a mix (see comments for grTransitions).
+			newState, boundary, rule = transAnyState[0], transAnyState[1] == wbBoundary, transAnyState[2]
+			if transAnyProp[2] < transAnyState[2] {
+				boundary, rule = transAnyProp[1] == wbBoundary, transAnyProp[2]
+			}
+		} else if okAnyProp {
+			// We only have a specific state.
+			newState, boundary, rule = transAnyProp[0], transAnyProp[1] == wbBoundary, transAnyProp[2]
+		} else if okAnyState {
+			// We only have a specific property.
+			newState, boundary, rule = transAnyState[0], transAnyState[1] == wbBoundary, transAnyState[2]
+		} else {
+			// No known transition. WB999: Any ÷ Any.
+			newState, boundary, rule = wbAny, true, 9990
+		}
+	}
+
+	// The rules below only apply if no rule before them matched.
+	if rule <= 60 {
+		return
+	}
+
+	// WB6, WB7b, and WB12 need to look at the property following the next code
+	// point, skipping over Extend, Format, and ZWJ (WB4).
+	if (state == wbALetter || state == wbHebrewLetter) &&
+		(nextProperty == prMidLetter || nextProperty == prMidNumLet || nextProperty == prSingleQuote) {
+		// WB6.
+		if farProperty := nextWordBreakProperty(b, str); farProperty == prALetter || farProperty == prHebrewLetter {
+			return wbWB7, false
+		}
+	}
+	if state == wbHebrewLetter && nextProperty == prDoubleQuote && rule > 72 {
+		// WB7b.
+		if nextWordBreakProperty(b, str) == prHebrewLetter {
+			return wbWB7c, false
+		}
+	}
+	if state == wbNumeric &&
+		(nextProperty == prMidNum || nextProperty == prMidNumLet || nextProperty == prSingleQuote) &&
+		rule > 120 {
+		// WB12.
+		if nextWordBreakProperty(b, str) == prNumeric {
+			return wbWB11, false
+		}
+	}
+
+	// WB15 and WB16.
+	if nextProperty == prRegionalIndicator {
+		if state == wbOddRI {
+			// Don't break pairs of Regional Indicators.
+			return wbEvenRI, false
+		}
+		return wbOddRI, true // We can break before the first and after a pair.
+	}
+
+	return
+}
+
+// nextWordBreakProperty returns the word break property of the first code
+// point in the byte slice or the string (whichever is not nil or empty),
+// skipping over Extend, Format, and ZWJ code points (WB4). It returns -1 if no
+// such code point can be found.
+func nextWordBreakProperty(b []byte, str string) int {
+	for {
+		var (
+			r      rune
+			length int
+		)
+		if b != nil { // Byte slice version.
+			r, length = utf8.DecodeRune(b)
+			b = b[length:]
+		} else { // String version.
+			r, length = utf8.DecodeRuneInString(str)
+			str = str[length:]
+		}
+		if length == 0 {
+			return -1 // End of text.
+		}
+		prop := property(wordBreakCodePoints, r)
+		if prop != prExtend && prop != prFormat && prop != prZWJ {
+			return prop
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package uniseg
+
+import (
+	"bufio"
+	"unicode/utf8"
+)
+
+// Wrap breaks the given string into lines which fit into the given monospace
+// width (see StringWidth()), also known as word wrapping. Lines are only
+// broken at line break opportunities according to Unicode Standard Annex #14
+// (see FirstLineSegment()) and at mandatory breaks such as newline characters.
+// If a single segment (e.g. a long word or URL) is wider than a line, it is
+// broken between grapheme clusters instead. A grapheme cluster which is wider
+// than the line by itself is put on a line of its own.
+//
+// The returned lines do not contain line terminators (e.g. "\n" or "\r\n"),
+// nor any trailing spaces, which do not count towards the width of a line
+// (they "hang" into the margin). Leading spaces at the start of the text or
+// after a mandatory break (e.g. indentation) are kept and count towards the
+// width. A word which doesn't fit after them is broken between grapheme
+// clusters. An empty string results in no lines, while empty lines in the text
+// are kept.
+func Wrap(s string, width int) (lines []string) {
+	for len(s) > 0 {
+		var line string
+		line, s = FirstWrappedLineInString(s, width)
+		lines = append(lines, line)
+	}
+	return
+}
+
+// WrappedLineCount returns the number of lines (rows) the given string would
+// occupy when wrapped at the given width, i.e. the number of lines returned by
+// Wrap(), without allocating them.
+func WrappedLineCount(s string, width int) (n int) {
+	for len(s) > 0 {
+		length, _ := firstWrappedLine(nil, s, width)
+		s = s[length:]
+		n++
+	}
+	return
+}
+
+// FirstWrappedLine returns the first line of the given byte slice when it is
+// wrapped at the given width, as Wrap() would return it. This function can be
+// called continuously to extract all lines from a byte slice, as follows:
+//
+//   for len(b) > 0 {
+//       line, b = uniseg.FirstWrappedLine(b, width)
+//       // Do something with line.
+//   }
+//
+// The "rest" slice is the subslice of the original byte slice "b" starting
+// after the line, including its trailing spaces and line terminator. If the
+// length of the "rest" slice is 0, the entire byte slice "b" has been
+// processed.
+//
+// For an empty byte slice "b", the function returns nil values.
+func FirstWrappedLine(b []byte, width int) (line, rest []byte) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
+		return
+	}
+	length, contentLength := firstWrappedLine(b, "", width)
+	return b[:contentLength], b[length:]
+}
+
+// FirstWrappedLineInString is like FirstWrappedLine() but its input and
+// outputs are strings.
+func FirstWrappedLineInString(str string, width int) (line, rest string) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
+	}
+	length, contentLength := firstWrappedLine(nil, str, width)
+	return str[:contentLength], str[length:]
+}
+
+// ScanWrappedLines returns a split function for a bufio.Scanner that returns
+// each line of the input wrapped at the given width as a token, as Wrap()
+// would return it:
+//
+//   scanner := bufio.NewScanner(reader)
+//   scanner.Split(uniseg.ScanWrappedLines(80))
+//   for scanner.Scan() {
+//       // Do something with scanner.Text() or scanner.Bytes().
+//   }
+//
+// A line is only returned once the data following it has been read or the end
+// of the input has been reached.
+func ScanWrappedLines(width int) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		// Ignore an incomplete UTF-8 sequence at the end of the buffer, it will
+		// be completed by the data to come.
+		if !atEOF {
+			data = trimIncompleteRune(data)
+		}
+		if len(data) == 0 {
+			return 0, nil, nil // Request more data or, at EOF, stop.
+		}
+
+		length, contentLength := firstWrappedLine(data, "", width)
+		if length == len(data) && !atEOF {
+			// The line may continue in the data to come.
+			return 0, nil, nil
+		}
+		return length, data[:contentLength], nil
+	}
+}
+
+// firstWrappedLine determines the first line of the byte slice or the string
+// (whichever is not nil) when wrapped at the given width. It returns the length
+// of the line in bytes, including trailing spaces and the line terminator, and
+// the length of the line's content, without them. The byte slice or string
+// must not be empty.
+func firstWrappedLine(b []byte, str string, width int) (length, contentLength int) {
+	var (
+		state     LineState
+		lineWidth int // Includes the trailing spaces of the last segment.
+		indent    int // The length of the leading spaces.
+		total     = len(str)
+	)
+	if b != nil {
+		total = len(b)
+	}
+
+	for length < total {
+		// Get the next segment.
+		var (
+			segment                    int
+			mustBreak                  bool
+			visible, content           int
+			contentWidth, visibleWidth int
+		)
+		if b != nil {
+			seg, _, m, s := FirstLineSegment(b[length:], state)
+			segment, mustBreak, state = len(seg), m, s
+			visible, content = lineSegmentContent(seg, "")
+			contentWidth = StringWidth(string(seg[:content]))
+			visibleWidth = contentWidth + StringWidth(string(seg[content:visible]))
+		} else {
+			seg, _, m, s := FirstLineSegmentInString(str[length:], state)
+			segment, mustBreak, state = len(seg), m, s
+			visible, content = lineSegmentContent(nil, seg)
+			contentWidth = StringWidth(seg[:content])
+			visibleWidth = contentWidth + StringWidth(seg[content:visible])
+		}
+
+		// Leading spaces are content.
+		if length == 0 && content == 0 && !mustBreak {
+			indent, content, contentWidth = segment, segment, visibleWidth
+		}
+
+		// Does it fit?
+		if lineWidth+contentWidth > width {
+			if length > indent {
+				// Break before this segment.
+				return
+			}
+
+			// The segment doesn't fit on a line by itself (or after the leading
+			// spaces). Break it between grapheme clusters, but include at least
+			// one cluster, unless the line already contains leading spaces.
+			var prefix int
+			if b != nil {
+				p, _ := widthPrefix(string(b[length:length+content]), width-lineWidth)
+				prefix = len(p)
+				if prefix == 0 && length == 0 {
+					cluster, _, _, _ := FirstGraphemeCluster(b, GraphemeState{})
+					prefix = len(cluster)
+				}
+			} else {
+				p, _ := widthPrefix(str[length:length+content], width-lineWidth)
+				prefix = len(p)
+				if prefix == 0 && length == 0 {
+					cluster, _, _, _ := FirstGraphemeClusterInString(str, GraphemeState{})
+					prefix = len(cluster)
+				}
+			}
+			if prefix >= content {
+				// The rest of the segment is spaces and line terminators.
+				return length + segment, length + content
+			}
+			return length + prefix, length + prefix
+		}
+
+		// Add the segment to the line.
+		if content > 0 {
+			contentLength = length + content
+		}
+		lineWidth += visibleWidth
+		length += segment
+		if mustBreak {
+			return
+		}
+	}
+
+	return
+}
+
+// lineSegmentContent examines a line segment as returned by FirstLineSegment()
+// from the byte slice or string (whichever is not nil) and returns its length
+// without any line terminator ("visible") and its length without trailing
+// spaces ("content").
+func lineSegmentContent(b []byte, str string) (visible, content int) {
+	var (
+		r      rune
+		length int
+	)
+	if b != nil {
+		r, length = utf8.DecodeLastRune(b)
+		visible = len(b)
+	} else {
+		r, length = utf8.DecodeLastRuneInString(str)
+		visible = len(str)
+	}
+
+	// Remove the line terminator (BK, CR, LF, NL).
+	switch r {
+	case '\n':
+		visible -= length
+		if visible > 0 && (b != nil && b[visible-1] == '\r' || b == nil && str[visible-1] == '\r') {
+			visible-- // CR LF.
+		}
+	case '\v', '\f', '\r', 0x85, 0x2028, 0x2029:
+		visible -= length
+	}
+
+	// Remove trailing spaces (SP).
+	content = visible
+	for content > 0 && (b != nil && b[content-1] == ' ' || b == nil && str[content-1] == ' ') {
+		content--
+	}
+
+	return
+}
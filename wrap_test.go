@@ -0,0 +1,147 @@
+package uniseg
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// Test cases for word wrapping.
+var wrapTestCases = []struct {
+	original string
+	width    int
+	expected []string
+}{
+	{"", 10, nil},
+	{"Hello", 10, []string{"Hello"}},
+	{"Hello", 5, []string{"Hello"}},
+	{"The quick brown fox jumps over the lazy dog.", 10, []string{"The quick", "brown fox", "jumps over", "the lazy", "dog."}},
+	{"The quick brown fox jumps over the lazy dog.", 5, []string{"The", "quick", "brown", "fox", "jumps", "over", "the", "lazy", "dog."}},
+	{"Trailing spaces hang.      ", 10, []string{"Trailing", "spaces", "hang."}},
+	{"a\n\nb\r\nc  \n", 10, []string{"a", "", "b", "c"}},
+	{"line\u2028separator", 20, []string{"line", "separator"}},
+	{"Supercalifragilisticexpialidocious is long", 10, []string{"Supercalif", "ragilistic", "expialidoc", "ious is", "long"}},
+	{"Sup er", 1, []string{"S", "u", "p", "e", "r"}},
+	{"a-b-c", 2, []string{"a-", "b-", "c"}},
+	{"世界世界世界世界 hello", 5, []string{"世界", "世界", "世界", "世界", "hello"}},
+	{"世界世界世界世界 hello", 10, []string{"世界世界世", "界世界", "hello"}},
+	{"世界", 1, []string{"世", "界"}},
+	{"x👍🏼👍🏼👍🏼", 5, []string{"x👍🏼👍🏼", "👍🏼"}},
+	{"e\u0301e\u0301e\u0301", 2, []string{"e\u0301e\u0301", "e\u0301"}},
+	{"Price: $100.00 each", 10, []string{"Price:", "$100.00", "each"}},
+	{"  lead", 4, []string{"  le", "ad"}},
+	{"  lead", 6, []string{"  lead"}},
+	{"    indented code line", 10, []string{"    indent", "ed code", "line"}},
+	{"    indented code line", 12, []string{"    indented", "code line"}},
+	{"a\n  b c", 3, []string{"a", "  b", "c"}},
+	{"  世界", 3, []string{"  ", "世", "界"}},
+	{"   ", 4, []string{""}},
+}
+
+// Test the Wrap() function.
+func TestWrap(t *testing.T) {
+	for index, testCase := range wrapTestCases {
+		lines := Wrap(testCase.original, testCase.width)
+		if strings.Join(lines, "|") != strings.Join(testCase.expected, "|") || len(lines) != len(testCase.expected) {
+			t.Errorf(`Test case %d failed: Wrap(%q, %d) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.width,
+				lines,
+				testCase.expected)
+		}
+		if n := WrappedLineCount(testCase.original, testCase.width); n != len(testCase.expected) {
+			t.Errorf(`Test case %d failed: WrappedLineCount(%q, %d) returned %d, expected %d`,
+				index,
+				testCase.original,
+				testCase.width,
+				n,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the FirstWrappedLine() function for byte slices.
+func TestWrapFunctionBytes(t *testing.T) {
+	for index, testCase := range wrapTestCases {
+		var (
+			line  []byte
+			lines []string
+		)
+		b := []byte(testCase.original)
+		for len(b) > 0 {
+			line, b = FirstWrappedLine(b, testCase.width)
+			lines = append(lines, string(line))
+		}
+		if strings.Join(lines, "|") != strings.Join(testCase.expected, "|") || len(lines) != len(testCase.expected) {
+			t.Errorf(`Test case %d failed: FirstWrappedLine(%q, %d) returned %q, expected %q`,
+				index,
+				testCase.original,
+				testCase.width,
+				lines,
+				testCase.expected)
+		}
+	}
+}
+
+// Test the ScanWrappedLines() split function, with data arriving one byte at a
+// time.
+func TestWrapScanner(t *testing.T) {
+	for index, testCase := range wrapTestCases {
+		scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(testCase.original)))
+		scanner.Split(ScanWrappedLines(testCase.width))
+		var lines []string
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			t.Errorf(`Test case %d failed: Scanner error: %v`, index, err)
+		}
+		if strings.Join(lines, "|") != strings.Join(testCase.expected, "|") || len(lines) != len(testCase.expected) {
+			t.Errorf(`Test case %d failed: ScanWrappedLines(%d) for %q returned %q, expected %q`,
+				index,
+				testCase.width,
+				testCase.original,
+				lines,
+				testCase.expected)
+		}
+	}
+}
+
+// Test that wrapped lines never exceed the width, unless they consist of a
+// single grapheme cluster, and that no text is lost except for line
+// terminators and trailing spaces.
+func TestWrapWidth(t *testing.T) {
+	allCases := append(testCases, unicodeTestCases...)
+	for index, testCase := range allCases {
+		for width := 1; width <= 6; width++ {
+			lines := Wrap(testCase.original, width)
+			var length int
+			for _, line := range lines {
+				if StringWidth(line) > width && GraphemeClusterCount(line) > 1 {
+					t.Errorf(`Test case %d failed: Wrap(%q, %d) returned line %q which is too wide`,
+						index,
+						testCase.original,
+						width,
+						line)
+				}
+				length += len(line)
+			}
+			if length > len(testCase.original) {
+				t.Errorf(`Test case %d failed: Wrap(%q, %d) returned %q which is longer than the original`,
+					index,
+					testCase.original,
+					width,
+					lines)
+			}
+		}
+	}
+}
+
+// Benchmark the Wrap() function.
+func BenchmarkWrap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Wrap(benchmarkStr, 20)
+	}
+}